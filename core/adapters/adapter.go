@@ -60,6 +60,13 @@ type BaseAdapter interface {
 	Perform(models.RunInput, *store.Store) models.RunOutput
 }
 
+// Simulator is implemented by adapters whose Perform has side effects that
+// must not happen while a job spec is being simulated. Simulate returns the
+// output Perform would pass on to the next task, without the side effects.
+type Simulator interface {
+	Simulate(models.RunInput) models.RunOutput
+}
+
 // PipelineAdapter wraps a BaseAdapter with requirements for execution in the pipeline.
 type PipelineAdapter struct {
 	BaseAdapter
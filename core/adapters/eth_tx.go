@@ -3,7 +3,9 @@ package adapters
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
@@ -23,7 +25,8 @@ const (
 )
 
 // EthTx holds the Address to send the result to and the FunctionSelector
-// to execute.
+// to execute. FromAddress pins the key the transaction is sent from,
+// otherwise it is chosen by ETH_KEY_SELECTION_POLICY.
 type EthTx struct {
 	Address          common.Address       `json:"address"`
 	FunctionSelector eth.FunctionSelector `json:"functionSelector"`
@@ -31,6 +34,7 @@ type EthTx struct {
 	DataFormat       string               `json:"format"`
 	GasPrice         *utils.Big           `json:"gasPrice" gorm:"type:numeric"`
 	GasLimit         uint64               `json:"gasLimit"`
+	FromAddress      *common.Address      `json:"fromAddress,omitempty"`
 }
 
 // TaskType returns the type of Adapter.
@@ -57,7 +61,20 @@ func (etx *EthTx) Perform(input models.RunInput, store *strpkg.Store) models.Run
 	}
 
 	data := utils.ConcatBytes(etx.FunctionSelector.Bytes(), etx.DataPrefix, value)
-	return createTxRunResult(etx.Address, etx.GasPrice, etx.GasLimit, data, input, store)
+	return createTxRunResult(etx.FromAddress, etx.Address, etx.GasPrice, etx.GasLimit, data, input, store)
+}
+
+// Simulate returns the calldata Perform would send to the configured address,
+// without creating a transaction.
+func (etx *EthTx) Simulate(input models.RunInput) models.RunOutput {
+	value, err := getTxData(etx, input)
+	if err != nil {
+		err = errors.Wrap(err, "while constructing EthTx data")
+		return models.NewRunOutputError(err)
+	}
+
+	data := utils.ConcatBytes(etx.FunctionSelector.Bytes(), etx.DataPrefix, value)
+	return simulatedTxRunResult(etx.Address, data)
 }
 
 // getTxData returns the data to save against the callback encoded according to
@@ -80,6 +97,7 @@ func getTxData(e *EthTx, input models.RunInput) ([]byte, error) {
 }
 
 func createTxRunResult(
+	from *common.Address,
 	address common.Address,
 	gasPrice *utils.Big,
 	gasLimit uint64,
@@ -87,14 +105,23 @@ func createTxRunResult(
 	input models.RunInput,
 	store *strpkg.Store,
 ) models.RunOutput {
-	tx, err := store.TxManager.CreateTxWithGas(
-		null.StringFrom(input.JobRunID().String()),
-		address,
-		data,
-		gasPrice.ToInt(),
-		gasLimit,
-	)
-	if err != nil {
+	var tx *models.Tx
+	var err error
+	surrogateID := null.StringFrom(input.JobRunID().String())
+	if from != nil {
+		tx, err = store.TxManager.CreateTxFrom(surrogateID, *from, address, data, gasPrice.ToInt(), gasLimit)
+	} else {
+		tx, err = store.TxManager.CreateTxWithGas(surrogateID, address, data, gasPrice.ToInt(), gasLimit)
+	}
+	if revertErr, ok := errors.Cause(err).(*eth.RevertError); ok {
+		return models.NewRunOutputError(revertErr)
+	} else if isPausedKeyError(err, store) {
+		// Retried on a later head, once the key is funded
+		logger.Warnw("Key is paused until it is funded, waiting to send transaction", "jobRunID", input.JobRunID().String(), "error", err)
+		return models.NewRunOutputPendingConnection()
+	} else if isPermanentTxError(err) {
+		return models.NewRunOutputError(err)
+	} else if err != nil {
 		return models.NewRunOutputPendingConfirmationsWithData(input.Data())
 	}
 
@@ -124,12 +151,41 @@ func createTxRunResult(
 			err := errors.New("missing receipt for transaction")
 			return models.NewRunOutputError(err)
 		}
-		return addReceiptToResult(*receipt, input, output)
+		return withRevertReason(withTxCost(addReceiptToResult(*receipt, input, output), *receipt, store), *receipt, store)
 	}
 
 	return models.NewRunOutputPendingConfirmationsWithData(output)
 }
 
+// isPermanentTxError returns whether creating the transaction would fail
+// again if retried, so the task errors rather than staying pending.
+func isPermanentTxError(err error) bool {
+	switch errors.Cause(err) {
+	case strpkg.ErrUnknownAccount, strpkg.ErrKeyBelowMinimumBalance, strpkg.ErrGasLimitExceedsMax:
+		return true
+	}
+	return false
+}
+
+// isPausedKeyError returns whether the transaction could not be sent because
+// its pinned key is empty while ETH_PAUSE_EMPTY_KEYS is set, in which case
+// the run waits for the key to be funded, as it does with no funded keys.
+func isPausedKeyError(err error, store *strpkg.Store) bool {
+	return errors.Cause(err) == strpkg.ErrKeyBelowMinimumBalance && store.Config.EthPauseEmptyKeys()
+}
+
+func simulatedTxRunResult(address common.Address, data []byte) models.RunOutput {
+	output, err := models.JSON{}.Add("result", hexutil.Encode(data))
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	output, err = output.Add("address", address.Hex())
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputComplete(output)
+}
+
 func ensureTxRunResult(input models.RunInput, str *strpkg.Store) models.RunOutput {
 	val, err := input.ResultString()
 	if err != nil {
@@ -174,12 +230,50 @@ func ensureTxRunResult(input models.RunInput, str *strpkg.Store) models.RunOutpu
 			return models.NewRunOutputError(err)
 		}
 
-		return addReceiptToResult(*receipt, input, output)
+		return withRevertReason(withTxCost(addReceiptToResult(*receipt, input, output), *receipt, str), *receipt, str)
 	}
 
 	return models.NewRunOutputPendingConfirmationsWithData(output)
 }
 
+// withTxCost attaches to the output the gas used by the receipt's
+// transaction, and the ETH paid for it. The price paid is taken from the
+// receipt when the node reports it, as EIP-1559 transactions usually pay less
+// than their max fee, and otherwise from the mined attempt.
+func withTxCost(output models.RunOutput, receipt eth.TxReceipt, store *strpkg.Store) models.RunOutput {
+	if output.HasError() || receipt.GasUsed == nil {
+		return output
+	}
+	gasUsed := receipt.GasUsed.ToInt()
+	if receipt.EffectiveGasPrice != nil {
+		ethSpent := new(big.Int).Mul(gasUsed, receipt.EffectiveGasPrice.ToInt())
+		return output.WithTxCost(gasUsed.Uint64(), (*assets.Eth)(ethSpent))
+	}
+	txAttempt, err := store.FindTxAttempt(receipt.Hash)
+	if err != nil {
+		logger.Warnw("Unable to find tx attempt to record its cost", "txHash", receipt.Hash.Hex(), "error", err)
+		return output.WithTxCost(gasUsed.Uint64(), nil)
+	}
+	ethSpent := new(big.Int).Mul(gasUsed, txAttempt.GasPrice.ToInt())
+	return output.WithTxCost(gasUsed.Uint64(), (*assets.Eth)(ethSpent))
+}
+
+// withRevertReason attaches to the output the reason the receipt's
+// transaction reverted with, which the TxManager records once it is safe.
+func withRevertReason(output models.RunOutput, receipt eth.TxReceipt, store *strpkg.Store) models.RunOutput {
+	if output.HasError() || !receipt.Failed() {
+		return output
+	}
+	tx, _, err := store.FindTxByAttempt(receipt.Hash)
+	if err != nil {
+		logger.Warnw("Unable to find reverted tx to record its revert reason", "txHash", receipt.Hash.Hex(), "error", err)
+		return output
+	} else if !tx.RevertReason.Valid {
+		return output
+	}
+	return output.WithRevertReason(tx.RevertReason.String)
+}
+
 func addReceiptToResult(
 	receipt eth.TxReceipt,
 	input models.RunInput,
@@ -21,14 +21,16 @@ import (
 const evmWordSize = 32
 
 // EthTxABIEncode holds the Address to send the result to and the FunctionABI
-// to use for encoding arguments.
+// to use for encoding arguments. FromAddress pins the key the transaction is
+// sent from, otherwise it is chosen by ETH_KEY_SELECTION_POLICY.
 type EthTxABIEncode struct {
 	// Ethereum address of the contract this task calls
 	Address common.Address `json:"address"`
 	// ABI of contract function this task calls
-	FunctionABI abi.Method `json:"functionABI"`
-	GasPrice    *utils.Big `json:"gasPrice" gorm:"type:numeric"`
-	GasLimit    uint64     `json:"gasLimit"`
+	FunctionABI abi.Method      `json:"functionABI"`
+	GasPrice    *utils.Big      `json:"gasPrice" gorm:"type:numeric"`
+	GasLimit    uint64          `json:"gasLimit"`
+	FromAddress *common.Address `json:"fromAddress,omitempty"`
 }
 
 // TaskType returns the type of Adapter.
@@ -47,8 +49,9 @@ func (etx *EthTxABIEncode) UnmarshalJSON(data []byte) error {
 			Name   string
 			Inputs abi.Arguments
 		}
-		GasPrice *utils.Big
-		GasLimit uint64
+		GasPrice    *utils.Big
+		GasLimit    uint64
+		FromAddress *common.Address
 	}
 
 	decoder := json.NewDecoder(bytes.NewReader(data))
@@ -62,6 +65,7 @@ func (etx *EthTxABIEncode) UnmarshalJSON(data []byte) error {
 	etx.FunctionABI.Inputs = fields.FunctionABI.Inputs
 	etx.GasPrice = fields.GasPrice
 	etx.GasLimit = fields.GasLimit
+	etx.FromAddress = fields.FromAddress
 	return nil
 }
 
@@ -78,11 +82,22 @@ func (etx *EthTxABIEncode) Perform(input models.RunInput, store *strpkg.Store) m
 			err = errors.Wrap(err, "while constructing EthTxABIEncode data")
 			return models.NewRunOutputError(err)
 		}
-		return createTxRunResult(etx.Address, etx.GasPrice, etx.GasLimit, data, input, store)
+		return createTxRunResult(etx.FromAddress, etx.Address, etx.GasPrice, etx.GasLimit, data, input, store)
 	}
 	return ensureTxRunResult(input, store)
 }
 
+// Simulate returns the ABI encoded calldata Perform would send to the
+// configured address, without creating a transaction.
+func (etx *EthTxABIEncode) Simulate(input models.RunInput) models.RunOutput {
+	data, err := etx.abiEncode(&input)
+	if err != nil {
+		err = errors.Wrap(err, "while constructing EthTxABIEncode data")
+		return models.NewRunOutputError(err)
+	}
+	return simulatedTxRunResult(etx.Address, data)
+}
+
 // abiEncode ABI-encodes the arguments passed in a RunResult's result field
 // according to etx.FunctionABI
 func (etx *EthTxABIEncode) abiEncode(input *models.RunInput) ([]byte, error) {
@@ -18,9 +18,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	ethpkg "github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,11 +39,14 @@ func TestEthTxABIEncodeAdapter_UnmarshallJSON(t *testing.T) {
 		      {"name": "z", "type": "string"}
 			]
 		  },
-		  "address": "0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+		  "address": "0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+		  "fromAddress": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
 		}`
 	var etx adapters.EthTxABIEncode
 	err := json.Unmarshal([]byte(valid), &etx)
 	assert.NoError(t, err)
+	require.NotNil(t, etx.FromAddress)
+	assert.Equal(t, common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"), *etx.FromAddress)
 	assert.Equal(t, "example", etx.FunctionABI.Name)
 	assert.Equal(t, "y", etx.FunctionABI.Inputs[1].Name)
 	assert.Equal(t, abi.ArrayTy, etx.FunctionABI.Inputs[1].Type.Elem.T)
@@ -62,6 +69,39 @@ func TestEthTxABIEncodeAdapter_UnmarshallJSON(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestEthTxABIEncodeAdapter_Perform_FromAddress(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	from := cltest.NewAddress()
+	txManager := new(mocks.TxManager)
+	tx := &models.Tx{Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
+	txManager.On("Connected").Return(true)
+	txManager.On("CreateTxFrom", mock.Anything, from, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tx, nil)
+	txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&ethpkg.TxReceipt{}, strpkg.Unconfirmed, nil)
+	store.TxManager = txManager
+
+	uint256Type, err := abi.NewType("uint256", "", []abi.ArgumentMarshaling{})
+	require.NoError(t, err)
+	adapter := adapters.EthTxABIEncode{
+		Address: cltest.NewAddress(),
+		FunctionABI: abi.Method{
+			Name:    "set",
+			RawName: "set",
+			Inputs:  []abi.Argument{{Name: "x", Type: uint256Type}},
+		},
+		FromAddress: &from,
+	}
+	input := cltest.NewRunInputWithResult(map[string]interface{}{"x": "0x2a"})
+	data := adapter.Perform(input, store)
+
+	assert.NoError(t, data.Error())
+	assert.Equal(t, models.RunStatusPendingConfirmations, data.Status())
+	txManager.AssertExpectations(t)
+}
+
 func TestEthTxABIEncodeAdapter_Perform_ConfirmedWithJSON(t *testing.T) {
 	uint256Type, err := abi.NewType("uint256", "", []abi.ArgumentMarshaling{})
 	var adapterUnderTest = adapters.EthTxABIEncode{
@@ -365,6 +365,137 @@ func TestEthTxAdapter_Perform_CreateTxWithGasErrorTreatsAsNotConnected(t *testin
 	txManager.AssertExpectations(t)
 }
 
+func TestEthTxAdapter_Perform_CreateTxWithGasRevertErrors(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	txManager.On("CreateTxWithGas",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil, &eth.RevertError{Reason: "not enough LINK"})
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{}
+	data := adapter.Perform(models.RunInput{}, store)
+
+	require.EqualError(t, data.Error(), "execution reverted: not enough LINK")
+	assert.Equal(t, models.RunStatusErrored, data.Status())
+
+	txManager.AssertExpectations(t)
+}
+
+func TestEthTxAdapter_Perform_GasLimitExceedsMaxErrors(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	txManager.On("CreateTxWithGas",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil, strpkg.ErrGasLimitExceedsMax)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{}
+	data := adapter.Perform(models.RunInput{}, store)
+
+	assert.Error(t, data.Error())
+	assert.Equal(t, models.RunStatusErrored, data.Status())
+	txManager.AssertExpectations(t)
+}
+
+func TestEthTxAdapter_Perform_FromAddress(t *testing.T) {
+	t.Parallel()
+
+	from := cltest.NewAddress()
+
+	t.Run("sends from the pinned key", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+
+		txManager := new(mocks.TxManager)
+		tx := &models.Tx{Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
+		txManager.On("Connected").Return(true)
+		txManager.On("CreateTxFrom", mock.Anything, from, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tx, nil)
+		txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&eth.TxReceipt{}, strpkg.Unconfirmed, nil)
+		store.TxManager = txManager
+
+		adapter := adapters.EthTx{FromAddress: &from}
+		data := adapter.Perform(models.RunInput{}, store)
+
+		assert.NoError(t, data.Error())
+		assert.Equal(t, models.RunStatusPendingConfirmations, data.Status())
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("errors if the key is unknown", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+
+		txManager := new(mocks.TxManager)
+		txManager.On("Connected").Return(true)
+		txManager.On("CreateTxFrom", mock.Anything, from, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, strpkg.ErrUnknownAccount)
+		store.TxManager = txManager
+
+		adapter := adapters.EthTx{FromAddress: &from}
+		data := adapter.Perform(models.RunInput{}, store)
+
+		assert.Error(t, data.Error())
+		assert.Equal(t, models.RunStatusErrored, data.Status())
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("errors if the key's balance is below the minimum", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+
+		txManager := new(mocks.TxManager)
+		txManager.On("Connected").Return(true)
+		txManager.On("CreateTxFrom", mock.Anything, from, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, strpkg.ErrKeyBelowMinimumBalance)
+		store.TxManager = txManager
+
+		adapter := adapters.EthTx{FromAddress: &from}
+		data := adapter.Perform(models.RunInput{}, store)
+
+		assert.Error(t, data.Error())
+		assert.Equal(t, models.RunStatusErrored, data.Status())
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("waits for the key to be funded if empty keys are paused", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+		store.Config.Set("ETH_PAUSE_EMPTY_KEYS", true)
+
+		txManager := new(mocks.TxManager)
+		txManager.On("Connected").Return(true)
+		txManager.On("CreateTxFrom", mock.Anything, from, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, strpkg.ErrKeyBelowMinimumBalance)
+		store.TxManager = txManager
+
+		adapter := adapters.EthTx{FromAddress: &from}
+		data := adapter.Perform(models.RunInput{}, store)
+
+		assert.NoError(t, data.Error())
+		assert.Equal(t, models.RunStatusPendingConnection, data.Status())
+		txManager.AssertExpectations(t)
+	})
+}
+
 func TestEthTxAdapter_Perform_CheckAttemptErrorTreatsAsNotConnected(t *testing.T) {
 	t.Parallel()
 
@@ -477,3 +608,27 @@ func TestEthTxAdapter_Perform_NoDoubleSpendOnSendTransactionFail(t *testing.T) {
 
 	txManager.AssertExpectations(t)
 }
+
+func TestEthTxAdapter_Simulate(t *testing.T) {
+	t.Parallel()
+
+	address := cltest.NewAddress()
+	adapter := adapters.EthTx{
+		Address:    address,
+		DataFormat: "bytes",
+		DataPrefix: hexutil.MustDecode("0x88888888"),
+	}
+	input := cltest.NewRunInputWithResult("cönfirmed")
+	output := adapter.Simulate(input)
+
+	require.NoError(t, output.Error())
+	assert.Equal(t, models.RunStatusCompleted, output.Status())
+	assert.Equal(t, "0x"+
+		"00000000"+ // function selector
+		"88888888"+ // data prefix
+		"0000000000000000000000000000000000000000000000000000000000000040"+ // offset
+		"000000000000000000000000000000000000000000000000000000000000000a"+ // length in bytes
+		"63c3b66e6669726d656400000000000000000000000000000000000000000000", // encoded string left padded
+		output.Result().String())
+	assert.Equal(t, address.Hex(), output.Get("address").String())
+}
@@ -176,14 +176,21 @@ func sendRequest(input models.RunInput, request *http.Request, config HTTPReques
 	}
 
 	responseBody := string(bytes)
+	metadata, err := models.JSON{}.MultiAdd(models.KV{
+		"statusCode":    statusCode,
+		"bytesReceived": len(bytes),
+	})
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
 
 	// This is either a client error caused on our end or a server error that persists even after retrying.
 	// Either way, there is no way for us to complete the run with a result.
 	if statusCode >= 400 {
-		return models.NewRunOutputError(errors.New(responseBody))
+		return models.NewRunOutputError(errors.New(responseBody)).WithMetadata(metadata)
 	}
 
-	return models.NewRunOutputCompleteWithResult(responseBody)
+	return models.NewRunOutputCompleteWithResult(responseBody).WithMetadata(metadata)
 }
 
 // withRetry executes the http request in a retry. Timeout is controlled with a context
@@ -99,6 +99,8 @@ func TestHTTPGet_Perform(t *testing.T) {
 				assert.Equal(t, test.want, result.Result().String())
 			}
 			assert.Equal(t, false, result.Status().PendingBridge())
+			assert.Equal(t, int64(test.status), result.Metadata().Get("statusCode").Int())
+			assert.Equal(t, int64(len(test.response)), result.Metadata().Get("bytesReceived").Int())
 		})
 	}
 }
@@ -30,6 +30,11 @@ func (adapter *Sleep) Perform(input models.RunInput, str *store.Store) models.Ru
 	return models.NewRunOutputComplete(models.JSON{})
 }
 
+// Simulate returns the same output as Perform without waiting.
+func (adapter *Sleep) Simulate(input models.RunInput) models.RunOutput {
+	return models.NewRunOutputComplete(models.JSON{})
+}
+
 // Duration returns the amount of sleeping this task should be paused for.
 func (adapter *Sleep) Duration() time.Duration {
 	return utils.DurationFromNow(adapter.Until.Time)
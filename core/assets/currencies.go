@@ -233,3 +233,33 @@ func (*Eth) Symbol() string {
 func (e *Eth) ToInt() *big.Int {
 	return (*big.Int)(e)
 }
+
+// Add defers to big.Int Add
+func (e *Eth) Add(x, y *Eth) *Eth {
+	return (*Eth)(e.ToInt().Add(x.ToInt(), y.ToInt()))
+}
+
+// Value returns the Eth value for serialization to database.
+func (e Eth) Value() (driver.Value, error) {
+	b := (big.Int)(e)
+	return b.String(), nil
+}
+
+// Scan reads the database value and returns an instance.
+func (e *Eth) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		if _, ok := e.SetString(v, 10); !ok {
+			return fmt.Errorf("Unable to set string %v of %T to base 10 big.Int for Eth", value, value)
+		}
+	case []uint8:
+		// The SQL library returns numeric() types as []uint8 of the string representation
+		if _, ok := e.SetString(string(v), 10); !ok {
+			return fmt.Errorf("Unable to set string %v of %T to base 10 big.Int for Eth", value, value)
+		}
+	default:
+		return fmt.Errorf("Unable to convert %v of %T to Eth", value, value)
+	}
+
+	return nil
+}
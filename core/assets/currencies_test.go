@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAssets_NewLinkAndString(t *testing.T) {
@@ -125,3 +126,17 @@ func TestAssets_Eth_UnmarshalJsonError(t *testing.T) {
 	err = json.Unmarshal([]byte(`1`), &eth)
 	assert.Equal(t, assets.ErrNoQuotesForCurrency, err)
 }
+
+func TestAssets_Eth_ValueAndScan(t *testing.T) {
+	t.Parallel()
+
+	value, err := assets.NewEth(42).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "42", value)
+
+	eth := assets.Eth{}
+	require.NoError(t, eth.Scan([]uint8("1000")))
+	assert.Equal(t, assets.NewEth(1000), &eth)
+
+	assert.Error(t, eth.Scan(int64(1)))
+}
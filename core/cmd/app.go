@@ -206,6 +206,12 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Show a specific Job's details",
 					Action: client.ShowJobSpec,
 				},
+				{
+					Name:        "simulate",
+					Usage:       "Run a Job Specification's tasks once without creating the Job or sending transactions",
+					Description: "Takes a Job Specification and optional request data, each as a JSON string or path to a JSON file",
+					Action:      client.SimulateJobSpec,
+				},
 			},
 		},
 
@@ -451,6 +457,33 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "cancel",
+					Usage:  "Replace an unconfirmed Ethereum Transaction with a zero value transaction to the node's own account at a bumped gas price, and cancel its job run",
+					Action: client.CancelTransaction,
+				},
+			},
+		},
+
+		{
+			Name:  "heads",
+			Usage: "Commands for inspecting the heads the node received",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the heads in descending order, with their latency and depth below the chain",
+					Action: client.IndexHeads,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "chain-id",
+							Usage: "optional flag to list the heads of one of ETH_CHAINS",
+						},
+					},
+				},
 			},
 		},
 	}...)
@@ -149,7 +149,7 @@ func logIfNonceOutOfSync(store *strpkg.Store) {
 	if account == nil {
 		return
 	}
-	lastNonce, err := store.GetLastNonce(account.Address)
+	lastNonce, err := store.GetLastNonce(store.ChainID, account.Address)
 	if err != nil {
 		logger.Error("database error when checking nonce: ", err)
 		return
@@ -240,7 +240,7 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) error {
 		return err
 	}
 
-	transactions, err := store.FindAllTxsInNonceRange(beginningNonce, endingNonce)
+	transactions, err := store.FindAllTxsInNonceRange(store.ChainID, beginningNonce, endingNonce)
 	if err != nil {
 		return err
 	}
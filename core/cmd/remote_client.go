@@ -179,6 +179,44 @@ func (cli *Client) CreateJobSpec(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &js)
 }
 
+// SimulateJobSpec runs a JobSpec's tasks once against optional request JSON,
+// without creating the job, and renders each task's input and output
+func (cli *Client) SimulateJobSpec(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in JobSpec [JSON blob | JSON filepath] and optional request data [JSON blob | JSON filepath]"))
+	}
+
+	spec, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	request := struct {
+		JobSpec json.RawMessage `json:"jobSpec"`
+		Data    json.RawMessage `json:"data,omitempty"`
+	}{JobSpec: spec.Bytes()}
+	if c.NArg() > 1 {
+		data, err := getBufferFromJSON(c.Args().Get(1))
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		request.Data = data.Bytes()
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/specs/simulate", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var simulation models.JobSimulation
+	return cli.renderAPIResponse(resp, &simulation)
+}
+
 // ArchiveJobSpec soft deletes a job and its associated runs.
 func (cli *Client) ArchiveJobSpec(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -453,6 +491,16 @@ func (cli *Client) IndexTransactions(c *clipkg.Context) error {
 	return cli.getPage("/v2/transactions", c.Int("page"), &[]presenters.Tx{})
 }
 
+// IndexHeads returns the list of the heads the node received in descending
+// order, taking optional page and chain ID parameters
+func (cli *Client) IndexHeads(c *clipkg.Context) error {
+	uri := "/v2/heads"
+	if c.IsSet("chain-id") {
+		uri += "?chainId=" + url.QueryEscape(c.String("chain-id"))
+	}
+	return cli.getPage(uri, c.Int("page"), &[]presenters.Head{})
+}
+
 // ShowTransaction returns the info for the given transaction hash
 func (cli *Client) ShowTransaction(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -468,6 +516,22 @@ func (cli *Client) ShowTransaction(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &tx)
 }
 
+// CancelTransaction replaces an unconfirmed transaction with a zero value
+// transaction to the node's own account at a bumped gas price
+func (cli *Client) CancelTransaction(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the hash of the transaction"))
+	}
+	hash := c.Args().First()
+	resp, err := cli.HTTP.Post("/v2/transactions/"+hash+"/cancel", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var tx presenters.Tx
+	return cli.renderAPIResponse(resp, &tx)
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *clipkg.Context) error {
@@ -770,6 +770,28 @@ func TestClient_ChangePassword(t *testing.T) {
 	assert.Contains(t, err.Error(), "401 Unauthorized")
 }
 
+func TestClient_IndexHeads(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	head := cltest.Head(1)
+	require.NoError(t, app.GetStore().CreateHead(head))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test heads", 0)
+	set.Int("page", 1, "doc")
+	c := cli.NewContext(nil, set, nil)
+	assert.NoError(t, client.IndexHeads(c))
+
+	renderedHeads := *r.Renders[0].(*[]presenters.Head)
+	require.Len(t, renderedHeads, 1)
+	assert.Equal(t, head.Hash, renderedHeads[0].Hash)
+}
+
 func TestClient_IndexTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -55,6 +55,8 @@ func (rt RendererTable) Render(v interface{}) error {
 		return rt.renderJobRuns(*typed)
 	case *presenters.JobRun:
 		return rt.renderJobRun(*typed)
+	case *models.JobSimulation:
+		return rt.renderJobSimulation(*typed)
 	case *models.BridgeType:
 		return rt.renderBridge(*typed)
 	case *models.BridgeTypeAuthentication:
@@ -71,6 +73,8 @@ func (rt RendererTable) Render(v interface{}) error {
 		return rt.renderTxs(*typed)
 	case *presenters.Tx:
 		return rt.renderTx(*typed)
+	case *[]presenters.Head:
+		return rt.renderHeads(*typed)
 	case *presenters.ExternalInitiatorAuthentication:
 		return rt.renderExternalInitiatorAuthentication(*typed)
 	case *web.ConfigPatchResponse:
@@ -212,8 +216,30 @@ func (rt RendererTable) renderJob(job presenters.JobSpec) error {
 }
 
 func (rt RendererTable) renderJobRun(run presenters.JobRun) error {
-	err := rt.renderJobRuns([]presenters.JobRun{run})
-	return err
+	if err := rt.renderJobRuns([]presenters.JobRun{run}); err != nil {
+		return err
+	}
+	return rt.renderTaskRuns(run.TaskRuns)
+}
+
+func (rt RendererTable) renderTaskRuns(taskRuns []models.TaskRun) error {
+	table := rt.newTable([]string{"Type", "Status", "Elapsed", "Params", "Input", "Output", "Metadata", "Error", "Revert Reason"})
+	for _, tr := range taskRuns {
+		table.Append([]string{
+			tr.TaskSpec.Type.String(),
+			string(tr.Status),
+			tr.Elapsed.String(),
+			tr.Params.String(),
+			tr.Input.String(),
+			tr.Result.Data.String(),
+			tr.Metadata.String(),
+			tr.Result.ErrorMessage.ValueOrZero(),
+			tr.RevertReason.ValueOrZero(),
+		})
+	}
+
+	render("Task Runs", table)
+	return nil
 }
 
 func (rt RendererTable) renderJobSingles(j presenters.JobSpec) error {
@@ -275,6 +301,22 @@ func (rt RendererTable) renderJobRuns(runs []presenters.JobRun) error {
 	return nil
 }
 
+func (rt RendererTable) renderJobSimulation(simulation models.JobSimulation) error {
+	table := rt.newTable([]string{"Type", "Status", "Input", "Output", "Error"})
+	for _, ts := range simulation.TaskRuns {
+		table.Append([]string{
+			ts.Task.Type.String(),
+			string(ts.Status),
+			ts.Input.String(),
+			ts.Output.String(),
+			ts.Error.ValueOrZero(),
+		})
+	}
+
+	render(fmt.Sprintf("Simulation (%s)", simulation.Status), table)
+	return nil
+}
+
 func (rt RendererTable) renderAccountBalances(balances []presenters.AccountBalance) error {
 	table := rt.newTable([]string{"Address", "ETH", "LINK"})
 	for _, ab := range balances {
@@ -340,12 +382,13 @@ func (rt RendererTable) renderTxAttempts(attempts []models.TxAttempt) error {
 }
 
 func (rt RendererTable) renderTx(tx presenters.Tx) error {
-	table := rt.newTable([]string{"From", "Nonce", "To", "Confirmed"})
+	table := rt.newTable([]string{"From", "Nonce", "To", "Confirmed", "Revert Reason"})
 	table.Append([]string{
 		tx.From.Hex(),
 		tx.Nonce,
 		tx.To.Hex(),
 		fmt.Sprint(tx.Confirmed),
+		tx.RevertReason.ValueOrZero(),
 	})
 
 	render(fmt.Sprintf("Ethereum Transaction %v", tx.Hash.Hex()), table)
@@ -369,6 +412,32 @@ func (rt RendererTable) renderTxs(txs []presenters.Tx) error {
 	return nil
 }
 
+func (rt RendererTable) renderHeads(heads []presenters.Head) error {
+	table := rt.newTable([]string{"Number", "Hash", "Timestamp", "Latency", "Depth"})
+	for _, head := range heads {
+		timestamp, latency, depth := "", "", ""
+		if head.Timestamp.Valid {
+			timestamp = utils.ISO8601UTC(head.Timestamp.Time)
+		}
+		if head.LatencyMs.Valid {
+			latency = fmt.Sprintf("%dms", head.LatencyMs.Int64)
+		}
+		if head.Depth.Valid {
+			depth = fmt.Sprint(head.Depth.Int64)
+		}
+		table.Append([]string{
+			fmt.Sprint(head.Number),
+			head.Hash.Hex(),
+			timestamp,
+			latency,
+			depth,
+		})
+	}
+
+	render("Heads", table)
+	return nil
+}
+
 func (rt RendererTable) renderConfigPatchResponse(config *web.ConfigPatchResponse) error {
 	table := rt.newTable([]string{"Config", "Old Value", "New Value"})
 	table.Append([]string{
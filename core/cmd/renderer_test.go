@@ -79,6 +79,20 @@ func TestRenderer_RenderJobRun(t *testing.T) {
 	}
 }
 
+func TestRendererTable_RenderJobRunTaskTrace(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	run := cltest.NewJobRun(job)
+	run.TaskRuns[0].Metadata = cltest.JSONFromString(t, `{"statusCode": 418}`)
+
+	tw := &testWriter{"418", t, false}
+	r := cmd.RendererTable{Writer: tw}
+
+	assert.NoError(t, r.Render(&presenters.JobRun{JobRun: run}))
+	assert.True(t, tw.found)
+}
+
 func TestRendererTable_RenderJobRun(t *testing.T) {
 	t.Parallel()
 	r := cmd.RendererTable{Writer: ioutil.Discard}
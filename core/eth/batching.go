@@ -0,0 +1,150 @@
+package eth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promBatchSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "eth_rpc_batch_size",
+		Help:    "Number of calls in each JSON-RPC batch sent to the Ethereum node",
+		Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200},
+	})
+	promBatchDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "eth_rpc_batch_duration_seconds",
+		Help:    "Round trip time of each JSON-RPC batch sent to the Ethereum node",
+		Buckets: prometheus.DefBuckets,
+	})
+)
+
+// batchableMethods are the read only calls that are coalesced into batches.
+var batchableMethods = map[string]bool{
+	"eth_call":                  true,
+	"eth_getBalance":            true,
+	"eth_getTransactionReceipt": true,
+}
+
+// BatchCaller performs several JSON-RPC calls in one request.
+type BatchCaller interface {
+	BatchCall(b []rpc.BatchElem) error
+}
+
+// BatchCallerSubscriber is a CallerSubscriber that can also send batches.
+type BatchCallerSubscriber interface {
+	CallerSubscriber
+	BatchCaller
+}
+
+// BatchingCallerSubscriber coalesces concurrent batchable calls into
+// JSON-RPC batches. Calls made while a batch is in flight are queued and sent
+// together in the next, up to maxBatchSize at a time. All other calls and
+// subscriptions go straight to the node.
+type BatchingCallerSubscriber struct {
+	BatchCallerSubscriber
+	maxBatchSize int
+
+	mutex    sync.Mutex
+	pending  []*batchRequest
+	flushing bool
+}
+
+type batchRequest struct {
+	elem rpc.BatchElem
+	done chan struct{}
+}
+
+// NewBatchingCallerSubscriber returns a CallerSubscriber which sends batches
+// of up to maxBatchSize calls.
+func NewBatchingCallerSubscriber(node BatchCallerSubscriber, maxBatchSize int) *BatchingCallerSubscriber {
+	return &BatchingCallerSubscriber{
+		BatchCallerSubscriber: node,
+		maxBatchSize:          maxBatchSize,
+	}
+}
+
+// Call queues batchable calls for the next batch and waits for the result.
+func (b *BatchingCallerSubscriber) Call(result interface{}, method string, args ...interface{}) error {
+	if !batchableMethods[method] || b.maxBatchSize < 2 {
+		return b.BatchCallerSubscriber.Call(result, method, args...)
+	}
+
+	req := &batchRequest{
+		elem: rpc.BatchElem{Method: method, Args: args, Result: result},
+		done: make(chan struct{}),
+	}
+	b.mutex.Lock()
+	b.pending = append(b.pending, req)
+	if !b.flushing {
+		b.flushing = true
+		go b.flush()
+	}
+	b.mutex.Unlock()
+
+	<-req.done
+	return req.elem.Error
+}
+
+// Subscribe subscribes on the node.
+func (b *BatchingCallerSubscriber) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (Subscription, error) {
+	return b.BatchCallerSubscriber.Subscribe(ctx, channel, args...)
+}
+
+// flush sends the pending calls, a batch at a time, until there are none.
+func (b *BatchingCallerSubscriber) flush() {
+	for {
+		b.mutex.Lock()
+		if len(b.pending) == 0 {
+			b.flushing = false
+			b.mutex.Unlock()
+			return
+		}
+		size := len(b.pending)
+		if size > b.maxBatchSize {
+			size = b.maxBatchSize
+		}
+		batch := b.pending[:size]
+		b.pending = b.pending[size:]
+		b.mutex.Unlock()
+
+		b.send(batch)
+	}
+}
+
+func (b *BatchingCallerSubscriber) send(batch []*batchRequest) {
+	defer func() {
+		for _, req := range batch {
+			close(req.done)
+		}
+	}()
+
+	start := time.Now()
+	defer func() {
+		promBatchSize.Observe(float64(len(batch)))
+		promBatchDuration.Observe(time.Since(start).Seconds())
+	}()
+
+	if len(batch) == 1 {
+		elem := &batch[0].elem
+		elem.Error = b.BatchCallerSubscriber.Call(elem.Result, elem.Method, elem.Args...)
+		return
+	}
+
+	elems := make([]rpc.BatchElem, len(batch))
+	for i, req := range batch {
+		elems[i] = req.elem
+	}
+	err := b.BatchCall(elems)
+	for i, req := range batch {
+		if err != nil {
+			req.elem.Error = err
+		} else {
+			req.elem.Error = elems[i].Error
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchingNode answers calls with their method, and blocks the first call
+// until released.
+type batchingNode struct {
+	calls   chan string
+	release chan struct{}
+
+	mutex   sync.Mutex
+	batches [][]string
+}
+
+func (n *batchingNode) Call(result interface{}, method string, args ...interface{}) error {
+	n.calls <- method
+	<-n.release
+	if method == "eth_call" {
+		return errors.New("execution reverted")
+	}
+	*result.(*string) = method
+	return nil
+}
+
+func (n *batchingNode) BatchCall(b []rpc.BatchElem) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	var methods []string
+	for i := range b {
+		methods = append(methods, b[i].Method)
+		if b[i].Method == "eth_call" {
+			b[i].Error = errors.New("execution reverted")
+			continue
+		}
+		*b[i].Result.(*string) = b[i].Method
+	}
+	n.batches = append(n.batches, methods)
+	return nil
+}
+
+func (n *batchingNode) Subscribe(context.Context, interface{}, ...interface{}) (Subscription, error) {
+	return nil, nil
+}
+
+func TestBatchingCallerSubscriber_CoalescesConcurrentCalls(t *testing.T) {
+	node := &batchingNode{calls: make(chan string, 1), release: make(chan struct{})}
+	batcher := NewBatchingCallerSubscriber(node, 2)
+
+	var wg sync.WaitGroup
+	call := func(method string, expectErr bool) {
+		defer wg.Done()
+		var result string
+		err := batcher.Call(&result, method)
+		if expectErr {
+			assert.Error(t, err)
+			return
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, method, result)
+	}
+
+	wg.Add(1)
+	go call("eth_getTransactionReceipt", false)
+	assert.Equal(t, "eth_getTransactionReceipt", <-node.calls, "a lone call is sent as is")
+
+	// Queued while the first call is in flight
+	wg.Add(3)
+	go call("eth_getBalance", false)
+	go call("eth_call", true)
+	go call("eth_getBalance", false)
+	assert.Eventually(t, func() bool {
+		batcher.mutex.Lock()
+		defer batcher.mutex.Unlock()
+		return len(batcher.pending) == 3
+	}, time.Second, 5*time.Millisecond)
+
+	close(node.release)
+	wg.Wait()
+
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	require.Len(t, node.batches, 1, "the call left over is sent alone")
+	assert.Len(t, node.batches[0], 2)
+}
+
+func TestBatchingCallerSubscriber_SendsOtherCallsDirectly(t *testing.T) {
+	node := &batchingNode{calls: make(chan string, 1), release: make(chan struct{})}
+	close(node.release)
+	batcher := NewBatchingCallerSubscriber(node, 100)
+
+	var result string
+	require.NoError(t, batcher.Call(&result, "eth_sendRawTransaction"))
+	assert.Equal(t, "eth_sendRawTransaction", <-node.calls)
+	assert.Empty(t, node.batches)
+}
@@ -2,6 +2,8 @@ package eth
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"math/big"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -23,10 +25,16 @@ type Client interface {
 	GetERC20Balance(address common.Address, contractAddress common.Address) (*big.Int, error)
 	SendRawTx(bytes []byte) (common.Hash, error)
 	GetTxReceipt(hash common.Hash) (*TxReceipt, error)
+	IsSyncing() (bool, error)
 	GetBlockHeight() (uint64, error)
+	GetChainHeight() (uint64, error)
 	GetLatestBlock() (Block, error)
 	GetBlockByNumber(hex string) (Block, error)
+	GetBlockHeaderByHash(hash common.Hash) (*BlockHeader, error)
 	GetChainID() (*big.Int, error)
+	GetFeeHistory(blockCount uint64, rewardPercentiles []float64) (FeeHistory, error)
+	EstimateGas(from, to common.Address, data []byte) (uint64, error)
+	GetRevertReason(args CallArgs, blockNumber *big.Int) (string, error)
 	SubscribeToNewHeads(ctx context.Context, channel chan<- BlockHeader) (Subscription, error)
 }
 
@@ -88,12 +96,16 @@ func (client *CallerSubscriberClient) GetEthBalance(address common.Address) (*as
 	return amount, nil
 }
 
-// CallArgs represents the data used to call the balance method of an ERC
-// contract. "To" is the address of the ERC contract. "Data" is the message sent
-// to the contract.
+// CallArgs represents the data used to call a contract, e.g. the balance
+// method of an ERC contract. "To" is the address of the contract. "Data" is
+// the message sent to the contract. "From", "Gas" and "Value" are optional,
+// and are used to replay transactions as they were sent.
 type CallArgs struct {
-	To   common.Address `json:"to"`
-	Data hexutil.Bytes  `json:"data"`
+	From  *common.Address `json:"from,omitempty"`
+	To    common.Address  `json:"to"`
+	Gas   *hexutil.Uint64 `json:"gas,omitempty"`
+	Value *hexutil.Big    `json:"value,omitempty"`
+	Data  hexutil.Bytes   `json:"data"`
 }
 
 // GetERC20Balance returns the balance of the given address for the token contract address.
@@ -134,6 +146,56 @@ func (client *CallerSubscriberClient) GetBlockHeight() (uint64, error) {
 	return uint64(height), err
 }
 
+// SyncProgress is the progress of a node syncing the chain, as returned by
+// eth_syncing.
+type SyncProgress struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// syncProgress returns the progress of the node's sync, or nil if it is not
+// syncing, in which case eth_syncing returns false.
+func (client *CallerSubscriberClient) syncProgress() (*SyncProgress, error) {
+	var result json.RawMessage
+	if err := client.Call(&result, "eth_syncing"); err != nil {
+		return nil, err
+	}
+	var syncing bool
+	if len(result) == 0 || json.Unmarshal(result, &syncing) == nil {
+		return nil, nil
+	}
+	var progress SyncProgress
+	if err := json.Unmarshal(result, &progress); err != nil {
+		return nil, fmt.Errorf("unable to decode eth_syncing: %v", err)
+	}
+	return &progress, nil
+}
+
+// IsSyncing returns whether the node is still syncing the chain.
+func (client *CallerSubscriberClient) IsSyncing() (bool, error) {
+	progress, err := client.syncProgress()
+	return progress != nil, err
+}
+
+// GetChainHeight returns the height of the chain as known to the node from
+// its peers. While the node is syncing, that is the highest block reported by
+// eth_syncing, which can be well above the node's own height.
+func (client *CallerSubscriberClient) GetChainHeight() (uint64, error) {
+	progress, err := client.syncProgress()
+	if err != nil {
+		return 0, err
+	}
+	height, err := client.GetBlockHeight()
+	if err != nil {
+		return 0, err
+	}
+	if progress != nil && uint64(progress.HighestBlock) > height {
+		return uint64(progress.HighestBlock), nil
+	}
+	return height, nil
+}
+
 // GetLatestBlock returns the last committed block of the best blockchain the
 // blockchain node is aware of.
 func (client *CallerSubscriberClient) GetLatestBlock() (Block, error) {
@@ -150,6 +212,20 @@ func (client *CallerSubscriberClient) GetBlockByNumber(hex string) (Block, error
 	return block, err
 }
 
+// GetBlockHeaderByHash returns the header of the block with the passed hash,
+// and errors if the node does not know of it.
+func (client *CallerSubscriberClient) GetBlockHeaderByHash(hash common.Hash) (*BlockHeader, error) {
+	var header *BlockHeader
+	err := client.Call(&header, "eth_getBlockByHash", hash, false)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, fmt.Errorf("block %s not found", hash.Hex())
+	}
+	return header, nil
+}
+
 // GetLogs returns all logs that respect the passed filter query.
 func (client *CallerSubscriberClient) GetLogs(q ethereum.FilterQuery) ([]Log, error) {
 	var results []Log
@@ -164,6 +240,56 @@ func (client *CallerSubscriberClient) GetChainID() (*big.Int, error) {
 	return value.ToInt(), err
 }
 
+// GetFeeHistory returns the base fees of the last blockCount blocks, along
+// with the given percentiles of the priority fees paid in each of them.
+func (client *CallerSubscriberClient) GetFeeHistory(blockCount uint64, rewardPercentiles []float64) (FeeHistory, error) {
+	var history FeeHistory
+	err := client.Call(&history, "eth_feeHistory", hexutil.Uint64(blockCount), "latest", rewardPercentiles)
+	return history, err
+}
+
+// EstimateGas returns the gas a transaction from the given address would use
+// if it was mined in the latest block. A *RevertError is returned if it would
+// revert.
+func (client *CallerSubscriberClient) EstimateGas(from, to common.Address, data []byte) (uint64, error) {
+	args := CallArgs{From: &from, To: to, Data: data}
+	var gas hexutil.Uint64
+	err := client.Call(&gas, "eth_estimateGas", args)
+	if err == nil {
+		return uint64(gas), nil
+	}
+	if revertErr := asRevertError(err); revertErr != nil {
+		return 0, revertErr
+	}
+
+	// Older nodes fail the estimate of a reverting call with a generic error,
+	// but return the revert reason as the result of eth_call.
+	var result hexutil.Bytes
+	callErr := client.Call(&result, "eth_call", args, "latest")
+	if revertErr := asRevertError(callErr); revertErr != nil {
+		return 0, revertErr
+	}
+	if reason, ok := DecodeRevertReason(result); callErr == nil && ok {
+		return 0, &RevertError{Reason: reason}
+	}
+	return 0, err
+}
+
+// GetRevertReason replays a call with eth_call on top of the given block, and
+// returns the reason it reverted with. The reason is empty if the call did not
+// revert, or reverted without giving one.
+func (client *CallerSubscriberClient) GetRevertReason(args CallArgs, blockNumber *big.Int) (string, error) {
+	var result hexutil.Bytes
+	err := client.Call(&result, "eth_call", args, hexutil.EncodeBig(blockNumber))
+	if revertErr := asRevertError(err); revertErr != nil {
+		return revertErr.Reason, nil
+	} else if err != nil {
+		return "", err
+	}
+	reason, _ := DecodeRevertReason(result)
+	return reason, nil
+}
+
 // SubscribeToLogs registers a subscription for push notifications of logs
 // from a given address.
 //
@@ -2,6 +2,7 @@ package eth_test
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"math/big"
@@ -102,6 +103,72 @@ func TestCallerSubscriberClient_GetNonce(t *testing.T) {
 	require.Equal(t, result, expected)
 }
 
+func TestCallerSubscriberClient_IsSyncing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response string
+		want     bool
+	}{
+		{"synced", `false`, false},
+		{"syncing", `{"startingBlock":"0x0","currentBlock":"0x1","highestBlock":"0x10"}`, true},
+		{"null", `null`, false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ethClientMock := new(mocks.CallerSubscriber)
+			ethClient := &eth.CallerSubscriberClient{CallerSubscriber: ethClientMock}
+			ethClientMock.On("Call", mock.Anything, "eth_syncing").
+				Return(nil).
+				Run(func(args mock.Arguments) {
+					require.NoError(t, json.Unmarshal([]byte(test.response), args.Get(0)))
+				})
+
+			syncing, err := ethClient.IsSyncing()
+			require.NoError(t, err)
+			assert.Equal(t, test.want, syncing)
+		})
+	}
+}
+
+func TestCallerSubscriberClient_GetChainHeight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response string
+		want     uint64
+	}{
+		{"synced", `false`, 16},
+		{"syncing", `{"startingBlock":"0x0","currentBlock":"0x10","highestBlock":"0x100"}`, 256},
+		{"syncing behind the node", `{"startingBlock":"0x0","currentBlock":"0x1","highestBlock":"0x8"}`, 16},
+		{"null", `null`, 16},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ethClientMock := new(mocks.CallerSubscriber)
+			ethClient := &eth.CallerSubscriberClient{CallerSubscriber: ethClientMock}
+			ethClientMock.On("Call", mock.Anything, "eth_syncing").
+				Return(nil).
+				Run(func(args mock.Arguments) {
+					require.NoError(t, json.Unmarshal([]byte(test.response), args.Get(0)))
+				})
+			ethClientMock.On("Call", mock.Anything, "eth_blockNumber").
+				Return(nil).
+				Run(func(args mock.Arguments) {
+					require.NoError(t, json.Unmarshal([]byte(`"0x10"`), args.Get(0)))
+				})
+
+			height, err := ethClient.GetChainHeight()
+			require.NoError(t, err)
+			assert.Equal(t, test.want, height)
+		})
+	}
+}
+
 func TestCallerSubscriberClient_SendRawTx(t *testing.T) {
 	t.Parallel()
 
@@ -199,3 +266,100 @@ func TestCallerSubscriberClient_GetERC20Balance(t *testing.T) {
 		})
 	}
 }
+
+func TestCallerSubscriberClient_EstimateGas(t *testing.T) {
+	t.Parallel()
+
+	revertData := hexutil.MustDecode("0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f6e6f7420656e6f756768204c494e4b0000000000000000000000000000000000")
+	from := cltest.NewAddress()
+	to := cltest.NewAddress()
+	data := hexutil.MustDecode("0xdeadbeef")
+	callArgs := eth.CallArgs{From: &from, To: to, Data: data}
+
+	tests := []struct {
+		name       string
+		estimate   error
+		callResult hexutil.Bytes
+		want       uint64
+		wantErr    string
+		wantRevert bool
+	}{
+		{"success", nil, nil, 21000, "", false},
+		{"reverted with reason", errors.New("execution reverted: not enough LINK"), nil, 0, "execution reverted: not enough LINK", true},
+		{"reverted on older node", errors.New("gas required exceeds allowance (8000000) or always failing transaction"), revertData, 0, "execution reverted: not enough LINK", true},
+		{"other error", errors.New("gas required exceeds allowance (8000000) or always failing transaction"), hexutil.Bytes{}, 0, "gas required exceeds allowance (8000000) or always failing transaction", false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ethClientMock := new(mocks.CallerSubscriber)
+			ethClient := &eth.CallerSubscriberClient{CallerSubscriber: ethClientMock}
+
+			ethClientMock.On("Call", mock.Anything, "eth_estimateGas", callArgs).
+				Return(test.estimate).
+				Run(func(args mock.Arguments) {
+					res := args.Get(0).(*hexutil.Uint64)
+					*res = hexutil.Uint64(test.want)
+				})
+			if test.callResult != nil {
+				ethClientMock.On("Call", mock.Anything, "eth_call", callArgs, "latest").
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						res := args.Get(0).(*hexutil.Bytes)
+						*res = test.callResult
+					})
+			}
+
+			gas, err := ethClient.EstimateGas(from, to, data)
+			if test.wantErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, test.want, gas)
+			} else {
+				require.EqualError(t, err, test.wantErr)
+				_, isRevert := err.(*eth.RevertError)
+				assert.Equal(t, test.wantRevert, isRevert)
+			}
+			ethClientMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCallerSubscriberClient_GetRevertReason(t *testing.T) {
+	t.Parallel()
+
+	revertData := hexutil.MustDecode("0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f6e6f7420656e6f756768204c494e4b0000000000000000000000000000000000")
+	from := cltest.NewAddress()
+	callArgs := eth.CallArgs{From: &from, To: cltest.NewAddress(), Data: hexutil.MustDecode("0xdeadbeef")}
+
+	tests := []struct {
+		name    string
+		callErr error
+		result  hexutil.Bytes
+		want    string
+	}{
+		{"revert data", nil, revertData, "not enough LINK"},
+		{"revert error", errors.New("execution reverted: not enough LINK"), nil, "not enough LINK"},
+		{"no reason", nil, hexutil.Bytes{}, ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ethClientMock := new(mocks.CallerSubscriber)
+			ethClient := &eth.CallerSubscriberClient{CallerSubscriber: ethClientMock}
+
+			ethClientMock.On("Call", mock.Anything, "eth_call", callArgs, "0x2a").
+				Return(test.callErr).
+				Run(func(args mock.Arguments) {
+					res := args.Get(0).(*hexutil.Bytes)
+					*res = test.result
+				})
+
+			reason, err := ethClient.GetRevertReason(callArgs, big.NewInt(42))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, reason)
+			ethClientMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package eth
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// DynamicFeeTxType is the EIP-2718 transaction type of EIP-1559 transactions.
+const DynamicFeeTxType = 0x02
+
+// DynamicFeeTx is an EIP-1559 transaction, paying at most GasFeeCap per gas
+// of which at most GasTipCap goes to the miner. The version of go-ethereum
+// used here predates typed transactions, so hashing and encoding are done
+// here instead.
+type DynamicFeeTx struct {
+	ChainID   *big.Int
+	Nonce     uint64
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+	Gas       uint64
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+}
+
+// accessTuple is an EIP-2930 access list entry. The node never populates an
+// access list, but it is part of the encoding.
+type accessTuple struct {
+	Address     common.Address
+	StorageKeys []common.Hash
+}
+
+func (tx *DynamicFeeTx) unsignedFields() []interface{} {
+	return []interface{}{
+		bigOrZero(tx.ChainID),
+		tx.Nonce,
+		bigOrZero(tx.GasTipCap),
+		bigOrZero(tx.GasFeeCap),
+		tx.Gas,
+		tx.To,
+		bigOrZero(tx.Value),
+		tx.Data,
+		[]accessTuple{},
+	}
+}
+
+// SigningHash returns the hash the sender signs,
+// keccak256(0x02 || rlp([chainId, nonce, ..., accessList])).
+func (tx *DynamicFeeTx) SigningHash() (common.Hash, error) {
+	payload, err := rlp.EncodeToBytes(tx.unsignedFields())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash([]byte{DynamicFeeTxType}, payload), nil
+}
+
+// EncodeSigned returns the raw transaction, ready for eth_sendRawTransaction,
+// given a 65 byte [R || S || V] signature of SigningHash with V being 0 or 1.
+// The transaction hash is the keccak256 of the returned bytes.
+func (tx *DynamicFeeTx) EncodeSigned(signature []byte) ([]byte, error) {
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("wrong size for signature: got %d, want 65", len(signature))
+	}
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:64])
+	v := uint64(signature[64])
+	if v > 1 {
+		return nil, fmt.Errorf("invalid signature recovery id %d", v)
+	}
+
+	payload, err := rlp.EncodeToBytes(append(tx.unsignedFields(), v, r, s))
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{DynamicFeeTxType}, payload...), nil
+}
+
+func bigOrZero(i *big.Int) *big.Int {
+	if i == nil {
+		return new(big.Int)
+	}
+	return i
+}
@@ -0,0 +1,71 @@
+package eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/eth"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicFeeTx_EncodeSigned(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	tx := eth.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     7,
+		GasTipCap: big.NewInt(2000000000),
+		GasFeeCap: big.NewInt(100000000000),
+		Gas:       21000,
+		To:        common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"),
+		Value:     big.NewInt(1),
+		Data:      []byte{0xca, 0xfe},
+	}
+	hash, err := tx.SigningHash()
+	require.NoError(t, err)
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	require.NoError(t, err)
+
+	raw, err := tx.EncodeSigned(signature)
+	require.NoError(t, err)
+	require.Equal(t, byte(eth.DynamicFeeTxType), raw[0])
+
+	var decoded struct {
+		ChainID    *big.Int
+		Nonce      uint64
+		GasTipCap  *big.Int
+		GasFeeCap  *big.Int
+		Gas        uint64
+		To         common.Address
+		Value      *big.Int
+		Data       []byte
+		AccessList []rlp.RawValue
+		V          uint64
+		R          *big.Int
+		S          *big.Int
+	}
+	require.NoError(t, rlp.DecodeBytes(raw[1:], &decoded))
+	assert.Equal(t, uint64(7), decoded.Nonce)
+	assert.Equal(t, tx.GasTipCap, decoded.GasTipCap)
+	assert.Equal(t, tx.GasFeeCap, decoded.GasFeeCap)
+	assert.Equal(t, tx.To, decoded.To)
+	assert.Equal(t, tx.Data, decoded.Data)
+	assert.Len(t, decoded.AccessList, 0)
+
+	sig := append(common.LeftPadBytes(decoded.R.Bytes(), 32), common.LeftPadBytes(decoded.S.Bytes(), 32)...)
+	sig = append(sig, byte(decoded.V))
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), crypto.PubkeyToAddress(*pub))
+
+	_, err = tx.EncodeSigned(signature[:64])
+	assert.Error(t, err)
+}
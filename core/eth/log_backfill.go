@@ -0,0 +1,49 @@
+package eth
+
+import (
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+)
+
+// LogBackfiller fetches logs from past blocks.
+type LogBackfiller interface {
+	GetBlockHeight() (uint64, error)
+	GetLogs(q ethereum.FilterQuery) ([]Log, error)
+}
+
+// GetLogsInBatches returns the logs matching the query, splitting its block
+// range into eth_getLogs calls of at most batchSize blocks, since nodes and
+// providers limit the range or the number of results of one call. Queries
+// without a ToBlock run up to the latest block. Queries without a FromBlock,
+// and a batchSize of zero, are sent as is.
+func GetLogsInBatches(client LogBackfiller, q ethereum.FilterQuery, batchSize uint64) ([]Log, error) {
+	if q.FromBlock == nil || batchSize == 0 {
+		return client.GetLogs(q)
+	}
+
+	toBlock := q.ToBlock
+	if toBlock == nil {
+		height, err := client.GetBlockHeight()
+		if err != nil {
+			return nil, err
+		}
+		toBlock = new(big.Int).SetUint64(height)
+	}
+
+	var logs []Log
+	step := new(big.Int).SetUint64(batchSize - 1)
+	for from := new(big.Int).Set(q.FromBlock); from.Cmp(toBlock) <= 0; from = new(big.Int).Add(q.ToBlock, big.NewInt(1)) {
+		q.FromBlock = from
+		q.ToBlock = new(big.Int).Add(from, step)
+		if q.ToBlock.Cmp(toBlock) > 0 {
+			q.ToBlock = toBlock
+		}
+		batch, err := client.GetLogs(q)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, batch...)
+	}
+	return logs, nil
+}
@@ -0,0 +1,42 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rangeRecorder returns one log for each block range it is asked for.
+type rangeRecorder struct {
+	height uint64
+	ranges [][2]int64
+}
+
+func (r *rangeRecorder) GetBlockHeight() (uint64, error) {
+	return r.height, nil
+}
+
+func (r *rangeRecorder) GetLogs(q ethereum.FilterQuery) ([]Log, error) {
+	r.ranges = append(r.ranges, [2]int64{q.FromBlock.Int64(), q.ToBlock.Int64()})
+	return []Log{{BlockNumber: q.FromBlock.Uint64()}}, nil
+}
+
+func TestGetLogsInBatches(t *testing.T) {
+	client := &rangeRecorder{height: 25}
+	logs, err := GetLogsInBatches(client, ethereum.FilterQuery{FromBlock: big.NewInt(3)}, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][2]int64{{3, 12}, {13, 22}, {23, 25}}, client.ranges)
+	assert.Len(t, logs, 3)
+}
+
+func TestGetLogsInBatches_UpToToBlock(t *testing.T) {
+	client := &rangeRecorder{height: 100}
+	_, err := GetLogsInBatches(client, ethereum.FilterQuery{FromBlock: big.NewInt(1), ToBlock: big.NewInt(4)}, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][2]int64{{1, 2}, {3, 4}}, client.ranges)
+}
@@ -0,0 +1,230 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxPolledHeads is the most heads sent for one poll, if the node has
+	// moved on by more than that since the last.
+	maxPolledHeads = 100
+	// maxPollFailures is the number of consecutive failed polls after which
+	// the subscription errors, to be resubscribed.
+	maxPollFailures = 3
+)
+
+// Caller performs JSON-RPC calls.
+type Caller interface {
+	Call(result interface{}, method string, args ...interface{}) error
+}
+
+// SubscribeByPolling emulates eth_subscribe for nodes that are only reachable
+// over HTTP, by polling the node every interval. It supports the newHeads and
+// logs subscriptions. As with eth_subscribe, only heads and logs after the
+// subscription are sent.
+func SubscribeByPolling(ctx context.Context, caller Caller, interval time.Duration, channel interface{}, args ...interface{}) (Subscription, error) {
+	if len(args) == 0 {
+		return nil, errors.New("SubscribeByPolling: missing subscription name")
+	}
+
+	sub := &pollingSubscription{
+		caller:   caller,
+		interval: interval,
+		chErr:    make(chan error, 1),
+		chStop:   make(chan struct{}),
+	}
+	latest, err := sub.blockNumber()
+	if err != nil {
+		return nil, errors.Wrap(err, "SubscribeByPolling")
+	}
+
+	var poll func() error
+	switch args[0] {
+	case "newHeads":
+		send, err := headSender(channel, sub.chStop)
+		if err != nil {
+			return nil, err
+		}
+		poll = sub.headPoller(latest, send)
+	case "logs":
+		send, err := logSender(channel, sub.chStop)
+		if err != nil {
+			return nil, err
+		}
+		var filter map[string]interface{}
+		if len(args) > 1 {
+			if f, ok := args[1].(map[string]interface{}); ok {
+				filter = f
+			}
+		}
+		poll = sub.logPoller(latest, filter, send)
+	default:
+		return nil, fmt.Errorf("SubscribeByPolling: unsupported subscription %v", args[0])
+	}
+
+	sub.wg.Add(1)
+	go sub.run(poll)
+	return sub, nil
+}
+
+type pollingSubscription struct {
+	caller          Caller
+	interval        time.Duration
+	chErr           chan error
+	chStop          chan struct{}
+	wg              sync.WaitGroup
+	unsubscribeOnce sync.Once
+}
+
+func (sub *pollingSubscription) Err() <-chan error {
+	return sub.chErr
+}
+
+func (sub *pollingSubscription) Unsubscribe() {
+	sub.unsubscribeOnce.Do(func() {
+		close(sub.chStop)
+		sub.wg.Wait()
+		close(sub.chErr)
+	})
+}
+
+func (sub *pollingSubscription) run(poll func() error) {
+	defer sub.wg.Done()
+	ticker := time.NewTicker(sub.interval)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		select {
+		case <-ticker.C:
+			if err := poll(); err != nil {
+				failures++
+				logger.Warnw("Failed to poll Ethereum node", "failures", failures, "error", err)
+				if failures >= maxPollFailures {
+					sub.chErr <- errors.Wrap(err, "polling subscription failed")
+					return
+				}
+				continue
+			}
+			failures = 0
+		case <-sub.chStop:
+			return
+		}
+	}
+}
+
+func (sub *pollingSubscription) blockNumber() (uint64, error) {
+	var latest hexutil.Uint64
+	err := sub.caller.Call(&latest, "eth_blockNumber")
+	return uint64(latest), err
+}
+
+// headPoller sends the header of every block after the last one sent, up to
+// maxPolledHeads at a time.
+func (sub *pollingSubscription) headPoller(last uint64, send func(BlockHeader) bool) func() error {
+	return func() error {
+		latest, err := sub.blockNumber()
+		if err != nil {
+			return err
+		}
+		from := last + 1
+		if latest >= maxPolledHeads && from < latest-maxPolledHeads+1 {
+			from = latest - maxPolledHeads + 1
+		}
+		for number := from; number <= latest; number++ {
+			var header *BlockHeader
+			err := sub.caller.Call(&header, "eth_getBlockByNumber", hexutil.EncodeUint64(number), false)
+			if err != nil {
+				return err
+			} else if header == nil {
+				// The node has not served the block yet, try again next time
+				return nil
+			}
+			if !send(*header) {
+				return nil
+			}
+			last = number
+		}
+		return nil
+	}
+}
+
+// logPoller sends the logs matching the filter in the blocks after the last
+// block polled.
+func (sub *pollingSubscription) logPoller(last uint64, filter map[string]interface{}, send func(Log) bool) func() error {
+	return func() error {
+		latest, err := sub.blockNumber()
+		if err != nil {
+			return err
+		} else if latest <= last {
+			return nil
+		}
+
+		arg := map[string]interface{}{}
+		for key, value := range filter {
+			arg[key] = value
+		}
+		arg["fromBlock"] = hexutil.EncodeUint64(last + 1)
+		arg["toBlock"] = hexutil.EncodeUint64(latest)
+
+		var logs []Log
+		if err := sub.caller.Call(&logs, "eth_getLogs", arg); err != nil {
+			return err
+		}
+		for _, log := range logs {
+			if !send(log) {
+				return nil
+			}
+		}
+		last = latest
+		return nil
+	}
+}
+
+func headSender(channel interface{}, chStop <-chan struct{}) (func(BlockHeader) bool, error) {
+	var ch chan<- BlockHeader
+	switch c := channel.(type) {
+	case chan<- BlockHeader:
+		ch = c
+	case chan BlockHeader:
+		ch = c
+	default:
+		return nil, fmt.Errorf("SubscribeByPolling: newHeads requires a chan BlockHeader, got %T", channel)
+	}
+	return func(header BlockHeader) bool {
+		select {
+		case ch <- header:
+			return true
+		case <-chStop:
+			return false
+		}
+	}, nil
+}
+
+func logSender(channel interface{}, chStop <-chan struct{}) (func(Log) bool, error) {
+	var ch chan<- Log
+	switch c := channel.(type) {
+	case chan<- Log:
+		ch = c
+	case chan Log:
+		ch = c
+	default:
+		return nil, fmt.Errorf("SubscribeByPolling: logs requires a chan Log, got %T", channel)
+	}
+	return func(log Log) bool {
+		select {
+		case ch <- log:
+			return true
+		case <-chStop:
+			return false
+		}
+	}, nil
+}
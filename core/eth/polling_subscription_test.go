@@ -0,0 +1,96 @@
+package eth_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/eth"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeByPolling_NewHeads(t *testing.T) {
+	caller := new(mocks.CallerSubscriber)
+	mockHead(caller, 10).Once()
+	mockHead(caller, 12).Once()
+	for _, number := range []int64{11, 12} {
+		number := number
+		caller.On("Call", mock.Anything, "eth_getBlockByNumber", hexutil.EncodeBig(big.NewInt(number)), false).Run(func(args mock.Arguments) {
+			*args.Get(0).(**eth.BlockHeader) = &eth.BlockHeader{Number: hexutil.Big(*big.NewInt(number))}
+		}).Return(nil).Once()
+	}
+	mockHead(caller, 12)
+
+	heads := make(chan eth.BlockHeader)
+	sub, err := eth.SubscribeByPolling(context.Background(), caller, 10*time.Millisecond, heads, "newHeads")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	for _, number := range []int64{11, 12} {
+		select {
+		case head := <-heads:
+			assert.Equal(t, number, head.Number.ToInt().Int64())
+		case <-time.After(time.Second):
+			t.Fatalf("head %d was not sent", number)
+		}
+	}
+	sub.Unsubscribe()
+	caller.AssertExpectations(t)
+}
+
+func TestSubscribeByPolling_Logs(t *testing.T) {
+	caller := new(mocks.CallerSubscriber)
+	address := common.HexToAddress("0x1")
+	filter := map[string]interface{}{"address": []common.Address{address}}
+
+	mockHead(caller, 10).Once()
+	mockHead(caller, 15).Once()
+	caller.On("Call", mock.Anything, "eth_getLogs", map[string]interface{}{
+		"address":   []common.Address{address},
+		"fromBlock": "0xb",
+		"toBlock":   "0xf",
+	}).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]eth.Log) = []eth.Log{{Address: address, BlockNumber: 13}}
+	}).Return(nil).Once()
+	mockHead(caller, 15)
+
+	logs := make(chan eth.Log)
+	sub, err := eth.SubscribeByPolling(context.Background(), caller, 10*time.Millisecond, logs, "logs", filter)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	select {
+	case log := <-logs:
+		assert.Equal(t, uint64(13), log.BlockNumber)
+	case <-time.After(time.Second):
+		t.Fatal("log was not sent")
+	}
+	sub.Unsubscribe()
+	assert.Len(t, filter, 1, "does not modify the filter")
+	caller.AssertExpectations(t)
+}
+
+func TestSubscribeByPolling_ErrorsAfterRepeatedFailures(t *testing.T) {
+	caller := new(mocks.CallerSubscriber)
+	mockHead(caller, 10).Once()
+	caller.On("Call", mock.Anything, "eth_blockNumber").Return(errors.New("connection refused"))
+
+	sub, err := eth.SubscribeByPolling(context.Background(), caller, 10*time.Millisecond, make(chan eth.BlockHeader), "newHeads")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	select {
+	case err := <-sub.Err():
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscription did not error")
+	}
+}
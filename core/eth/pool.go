@@ -0,0 +1,512 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promPoolNodeHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_pool_node_healthy",
+		Help: "Whether each Ethereum node in the pool is healthy (1) or not (0)",
+	},
+		[]string{"node"},
+	)
+	promPoolFailovers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "eth_pool_failovers_total",
+		Help: "Number of times the pool switched to a different Ethereum node",
+	})
+)
+
+// errorRateWeight is the weight of the latest call in a node's error rate
+// and latency, which are exponentially weighted moving averages.
+const errorRateWeight = 0.2
+
+// ErrNodeFailover is sent to the subscriptions on a node when the pool
+// switches away from it, so that they are resubscribed on the new node.
+var ErrNodeFailover = errors.New("subscription closed by failover to another Ethereum node")
+
+// PoolConfig configures the health checks of a Pool.
+type PoolConfig struct {
+	// PollInterval is how often each node's head is checked.
+	PollInterval time.Duration
+	// MaxHeadLag is how many blocks a node may be behind the highest head
+	// seen across the pool and still be healthy.
+	MaxHeadLag uint64
+	// MaxErrorRate is the error rate, between 0 and 1, above which a node is
+	// unhealthy.
+	MaxErrorRate float64
+	// CallTimeout is how long a node has to respond to a call before the
+	// next node is tried. Zero waits indefinitely.
+	CallTimeout time.Duration
+}
+
+// PoolNode is an endpoint of a Pool. Name identifies it in logs and metrics,
+// so that URLs, which often contain API keys, are not exposed.
+type PoolNode struct {
+	Name    string
+	Primary bool
+	CallerSubscriber
+}
+
+// Pool is a CallerSubscriber backed by several Ethereum nodes. Calls and
+// subscriptions go to the active node, which is the healthiest primary node,
+// or the healthiest secondary node if no primary is healthy. Calls that fail
+// to reach a node are retried on the others. Raw transactions are sent to
+// every healthy node, so that they propagate faster.
+type Pool struct {
+	nodes         []*poolNode
+	config        PoolConfig
+	active        *poolNode
+	subscriptions map[*poolSubscription]struct{}
+	mutex         sync.RWMutex
+	chStop        chan struct{}
+	wg            sync.WaitGroup
+}
+
+var _ CallerSubscriber = (*Pool)(nil)
+
+// NewPool creates a pool of the given nodes, the first of which is active
+// until the first health check.
+func NewPool(nodes []PoolNode, config PoolConfig) *Pool {
+	if len(nodes) == 0 {
+		panic("eth.NewPool requires at least one node")
+	}
+	pool := &Pool{
+		config:        config,
+		subscriptions: make(map[*poolSubscription]struct{}),
+		chStop:        make(chan struct{}),
+	}
+	for _, node := range nodes {
+		pool.nodes = append(pool.nodes, &poolNode{PoolNode: node})
+	}
+	pool.active = pool.nodes[0]
+	return pool
+}
+
+// Start begins checking the health of the nodes every PollInterval.
+func (p *Pool) Start() {
+	if p.config.PollInterval <= 0 {
+		logger.Warn("Ethereum node poll interval is 0, health checks and failover are disabled")
+		return
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.CheckHealth()
+
+		ticker := time.NewTicker(p.config.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.CheckHealth()
+			case <-p.chStop:
+				return
+			}
+		}
+	}()
+}
+
+// Close stops the health checks.
+func (p *Pool) Close() {
+	close(p.chStop)
+	p.wg.Wait()
+}
+
+// CheckHealth fetches the head of every node, then fails over if the active
+// node is no longer the best choice.
+func (p *Pool) CheckHealth() {
+	var wg sync.WaitGroup
+	for _, node := range p.nodes {
+		wg.Add(1)
+		go func(node *poolNode) {
+			defer wg.Done()
+			p.checkHead(node)
+		}(node)
+	}
+	wg.Wait()
+
+	maxHead := p.maxHead()
+	for _, node := range p.nodes {
+		healthy := 0.0
+		if node.healthy(maxHead, p.config) {
+			healthy = 1
+		}
+		promPoolNodeHealthy.WithLabelValues(node.Name).Set(healthy)
+	}
+	p.selectActive()
+}
+
+func (p *Pool) checkHead(node *poolNode) {
+	type result struct {
+		head hexutil.Uint64
+		err  error
+	}
+	chResult := make(chan result, 1)
+	start := time.Now()
+	go func() {
+		var head hexutil.Uint64
+		err := node.Call(&head, "eth_blockNumber")
+		chResult <- result{head, err}
+	}()
+
+	select {
+	case r := <-chResult:
+		node.record(r.err, time.Since(start))
+		if r.err == nil {
+			node.setHead(uint64(r.head))
+		}
+	case <-time.After(p.config.PollInterval):
+		node.record(errors.New("timed out"), p.config.PollInterval)
+	}
+}
+
+func (p *Pool) maxHead() uint64 {
+	var max uint64
+	for _, node := range p.nodes {
+		if state := node.state(); state.head > max {
+			max = state.head
+		}
+	}
+	return max
+}
+
+// selectActive switches to the best healthy node, if it is not already
+// active. If no node is healthy, the active node is kept.
+func (p *Pool) selectActive() {
+	maxHead := p.maxHead()
+	best := p.ranked(maxHead)[0]
+	if !best.healthy(maxHead, p.config) {
+		logger.Errorw("No healthy Ethereum node in the pool", "active", p.activeNode().Name)
+		return
+	}
+
+	p.mutex.Lock()
+	previous := p.active
+	if previous == best || (previous.healthy(maxHead, p.config) && previous.Primary == best.Primary) {
+		p.mutex.Unlock()
+		return
+	}
+	p.active = best
+	subscriptions := make([]*poolSubscription, 0, len(p.subscriptions))
+	for sub := range p.subscriptions {
+		subscriptions = append(subscriptions, sub)
+	}
+	p.mutex.Unlock()
+
+	promPoolFailovers.Inc()
+	logger.Warnw("Failing over to another Ethereum node", "from", previous.Name, "to", best.Name, "subscriptions", len(subscriptions))
+	for _, sub := range subscriptions {
+		if sub.node != best {
+			sub.fail(ErrNodeFailover)
+		}
+	}
+}
+
+// ranked returns the nodes from best to worst: healthy before unhealthy,
+// primary before secondary, then by head lag, error rate and latency.
+func (p *Pool) ranked(maxHead uint64) []*poolNode {
+	ranked := make([]*poolNode, len(p.nodes))
+	copy(ranked, p.nodes)
+	for i := 1; i < len(ranked); i++ {
+		for j := i; j > 0 && ranked[j].betterThan(ranked[j-1], maxHead, p.config); j-- {
+			ranked[j], ranked[j-1] = ranked[j-1], ranked[j]
+		}
+	}
+	return ranked
+}
+
+func (p *Pool) activeNode() *poolNode {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.active
+}
+
+// candidates returns the active node followed by the others, best first.
+func (p *Pool) candidates() []*poolNode {
+	active := p.activeNode()
+	candidates := []*poolNode{active}
+	for _, node := range p.ranked(p.maxHead()) {
+		if node != active {
+			candidates = append(candidates, node)
+		}
+	}
+	return candidates
+}
+
+// Call performs the call on the active node, trying the other nodes if it
+// cannot be reached or does not respond within CallTimeout.
+func (p *Pool) Call(result interface{}, method string, args ...interface{}) error {
+	if method == "eth_sendRawTransaction" {
+		return p.broadcast(result, method, args...)
+	}
+
+	var err error
+	for _, node := range p.candidates() {
+		err = node.call(p.config.CallTimeout, result, method, args...)
+		if !isTransportError(err) {
+			return err
+		}
+		logger.Warnw("Ethereum node call failed, trying the next node", "node", node.Name, "method", method, "error", err)
+	}
+	return err
+}
+
+// broadcast sends the call to every healthy node, and returns the active
+// node's response unless it could not be reached.
+func (p *Pool) broadcast(result interface{}, method string, args ...interface{}) error {
+	maxHead := p.maxHead()
+	candidates := p.candidates()
+	responses := make([]json.RawMessage, len(candidates))
+	errs := make([]error, len(candidates))
+
+	var wg sync.WaitGroup
+	for i, node := range candidates {
+		if i > 0 && !node.healthy(maxHead, p.config) {
+			errs[i] = errors.New("node is unhealthy")
+			continue
+		}
+		wg.Add(1)
+		go func(i int, node *poolNode) {
+			defer wg.Done()
+			errs[i] = node.call(p.config.CallTimeout, &responses[i], method, args...)
+		}(i, node)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if i == 0 && !isTransportError(err) {
+			if err != nil {
+				return err
+			}
+			return unmarshalResult(responses[i], result)
+		} else if err == nil {
+			return unmarshalResult(responses[i], result)
+		}
+	}
+	return errs[0]
+}
+
+func unmarshalResult(response json.RawMessage, result interface{}) error {
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(response, result)
+}
+
+// Subscribe subscribes on the active node, trying the other nodes if it
+// cannot be reached. The subscription errors with ErrNodeFailover if the pool
+// fails over to another node, and must then be resubscribed.
+func (p *Pool) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (Subscription, error) {
+	var err error
+	for _, node := range p.candidates() {
+		var sub Subscription
+		sub, err = node.Subscribe(ctx, channel, args...)
+		if err == nil {
+			return p.track(node, sub), nil
+		}
+		node.record(err, 0)
+		logger.Warnw("Ethereum node subscription failed, trying the next node", "node", node.Name, "error", err)
+	}
+	return nil, err
+}
+
+func (p *Pool) track(node *poolNode, inner Subscription) *poolSubscription {
+	sub := &poolSubscription{
+		pool:  p,
+		node:  node,
+		inner: inner,
+		chErr: make(chan error, 1),
+		done:  make(chan struct{}),
+	}
+	p.mutex.Lock()
+	p.subscriptions[sub] = struct{}{}
+	p.mutex.Unlock()
+
+	go sub.forwardErrors()
+	return sub
+}
+
+func (p *Pool) untrack(sub *poolSubscription) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.subscriptions, sub)
+}
+
+// isTransportError returns true if the node could not be reached or did not
+// respond. An error returned by the node itself, such as a reverted call, is
+// not a reason to try another node.
+func isTransportError(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(rpc.Error)
+	return !ok
+}
+
+type poolNode struct {
+	PoolNode
+	mutex     sync.RWMutex
+	head      uint64
+	errorRate float64
+	latency   time.Duration
+}
+
+type poolNodeState struct {
+	head      uint64
+	errorRate float64
+	latency   time.Duration
+}
+
+// call performs the call on the node. If the node has not responded by the
+// timeout, the call fails with a transport error, and the node's response is
+// discarded when it arrives.
+func (n *poolNode) call(timeout time.Duration, result interface{}, method string, args ...interface{}) error {
+	start := time.Now()
+	if timeout <= 0 {
+		err := n.Call(result, method, args...)
+		n.record(err, time.Since(start))
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var response json.RawMessage
+	chErr := make(chan error, 1)
+	go func() {
+		chErr <- n.Call(&response, method, args...)
+	}()
+
+	var err error
+	select {
+	case err = <-chErr:
+	case <-ctx.Done():
+		err = errors.Wrapf(ctx.Err(), "%s did not respond to %s within %s", n.Name, method, timeout)
+	}
+	n.record(err, time.Since(start))
+	if err != nil {
+		return err
+	}
+	return unmarshalResult(response, result)
+}
+
+// record updates the node's error rate and latency with the outcome of a
+// call. Only failures to reach the node count as errors.
+func (n *poolNode) record(err error, latency time.Duration) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	failed := 0.0
+	if isTransportError(err) {
+		failed = 1
+	} else if latency > 0 {
+		n.latency = time.Duration(errorRateWeight*float64(latency) + (1-errorRateWeight)*float64(n.latency))
+	}
+	n.errorRate = errorRateWeight*failed + (1-errorRateWeight)*n.errorRate
+}
+
+func (n *poolNode) setHead(head uint64) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.head = head
+}
+
+func (n *poolNode) state() poolNodeState {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return poolNodeState{head: n.head, errorRate: n.errorRate, latency: n.latency}
+}
+
+func (n *poolNode) lag(maxHead uint64) uint64 {
+	state := n.state()
+	if state.head > maxHead {
+		return 0
+	}
+	return maxHead - state.head
+}
+
+func (n *poolNode) healthy(maxHead uint64, config PoolConfig) bool {
+	return n.state().errorRate <= config.MaxErrorRate && n.lag(maxHead) <= config.MaxHeadLag
+}
+
+func (n *poolNode) betterThan(other *poolNode, maxHead uint64, config PoolConfig) bool {
+	if healthy, otherHealthy := n.healthy(maxHead, config), other.healthy(maxHead, config); healthy != otherHealthy {
+		return healthy
+	} else if n.Primary != other.Primary {
+		return n.Primary
+	} else if lag, otherLag := n.lag(maxHead), other.lag(maxHead); lag != otherLag {
+		return lag < otherLag
+	}
+	state, otherState := n.state(), other.state()
+	if state.errorRate != otherState.errorRate {
+		return state.errorRate < otherState.errorRate
+	}
+	return state.latency < otherState.latency
+}
+
+// poolSubscription wraps a subscription on one of the pool's nodes, so that
+// the pool can close it when failing over.
+type poolSubscription struct {
+	pool      *Pool
+	node      *poolNode
+	inner     Subscription
+	chErr     chan error
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (s *poolSubscription) Err() <-chan error {
+	return s.chErr
+}
+
+func (s *poolSubscription) Unsubscribe() {
+	s.close(nil)
+}
+
+func (s *poolSubscription) fail(err error) {
+	s.close(err)
+}
+
+func (s *poolSubscription) close(err error) {
+	s.closeOnce.Do(func() {
+		s.pool.untrack(s)
+		close(s.done)
+		s.inner.Unsubscribe()
+		if err != nil {
+			s.chErr <- err
+		}
+		close(s.chErr)
+	})
+}
+
+// forwardErrors closes the subscription with an error if the subscription on
+// the node fails or ends.
+func (s *poolSubscription) forwardErrors() {
+	select {
+	case err, ok := <-s.inner.Err():
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+		if !ok || err == nil {
+			err = errors.New("subscription ended")
+		}
+		s.node.record(err, 0)
+		s.close(fmt.Errorf("subscription on Ethereum node %s failed: %v", s.node.Name, err))
+	case <-s.done:
+	}
+}
@@ -0,0 +1,129 @@
+package eth_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/eth"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonRPCError is an error returned by a node that was reached.
+type jsonRPCError struct{}
+
+func (jsonRPCError) Error() string  { return "execution reverted" }
+func (jsonRPCError) ErrorCode() int { return 3 }
+
+var testPoolConfig = eth.PoolConfig{PollInterval: time.Second, MaxHeadLag: 5, MaxErrorRate: 0.5}
+
+func newTestPool() (*eth.Pool, *mocks.CallerSubscriber, *mocks.CallerSubscriber) {
+	primary := new(mocks.CallerSubscriber)
+	secondary := new(mocks.CallerSubscriber)
+	pool := eth.NewPool([]eth.PoolNode{
+		{Name: "primary", Primary: true, CallerSubscriber: primary},
+		{Name: "secondary-1", CallerSubscriber: secondary},
+	}, testPoolConfig)
+	return pool, primary, secondary
+}
+
+func mockHead(node *mocks.CallerSubscriber, head uint64) *mock.Call {
+	return node.On("Call", mock.Anything, "eth_blockNumber").Run(func(args mock.Arguments) {
+		*args.Get(0).(*hexutil.Uint64) = hexutil.Uint64(head)
+	}).Return(nil)
+}
+
+func TestPool_Call_FailsOverOnTransportErrors(t *testing.T) {
+	pool, primary, secondary := newTestPool()
+
+	primary.On("Call", mock.Anything, "eth_getBalance", "0x1").Return(errors.New("connection refused")).Once()
+	secondary.On("Call", mock.Anything, "eth_getBalance", "0x1").Return(nil).Once()
+	require.NoError(t, pool.Call(nil, "eth_getBalance", "0x1"))
+
+	primary.On("Call", mock.Anything, "eth_call", "0x2").Return(jsonRPCError{}).Once()
+	assert.Equal(t, jsonRPCError{}, pool.Call(nil, "eth_call", "0x2"), "errors from the node are not retried")
+
+	primary.AssertExpectations(t)
+	secondary.AssertExpectations(t)
+}
+
+func TestPool_Call_FailsOverOnTimeouts(t *testing.T) {
+	primary := new(mocks.CallerSubscriber)
+	secondary := new(mocks.CallerSubscriber)
+	config := testPoolConfig
+	config.CallTimeout = 50 * time.Millisecond
+	pool := eth.NewPool([]eth.PoolNode{
+		{Name: "primary", Primary: true, CallerSubscriber: primary},
+		{Name: "secondary-1", CallerSubscriber: secondary},
+	}, config)
+
+	primary.On("Call", mock.Anything, "eth_getBalance", "0x1").WaitUntil(time.After(time.Second)).Return(nil).Once()
+	secondary.On("Call", mock.Anything, "eth_getBalance", "0x1").Run(func(args mock.Arguments) {
+		*args.Get(0).(*json.RawMessage) = json.RawMessage(`"0x100"`)
+	}).Return(nil).Once()
+
+	var balance string
+	require.NoError(t, pool.Call(&balance, "eth_getBalance", "0x1"))
+	assert.Equal(t, "0x100", balance)
+
+	secondary.AssertExpectations(t)
+}
+
+func TestPool_CheckHealth_FailsOverLaggingNodes(t *testing.T) {
+	pool, primary, secondary := newTestPool()
+
+	inner := new(mocks.Subscription)
+	inner.On("Err").Return(nil)
+	inner.On("Unsubscribe").Return().Once()
+	primary.On("Subscribe", mock.Anything, mock.Anything, "newHeads").Return(inner, nil).Once()
+	sub, err := pool.Subscribe(context.Background(), make(chan eth.BlockHeader), "newHeads")
+	require.NoError(t, err)
+
+	mockHead(primary, 100).Once()
+	mockHead(secondary, 110).Once()
+	pool.CheckHealth()
+
+	select {
+	case err := <-sub.Err():
+		assert.Equal(t, eth.ErrNodeFailover, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed by the failover")
+	}
+
+	secondary.On("Call", mock.Anything, "eth_getBalance").Return(nil).Once()
+	require.NoError(t, pool.Call(nil, "eth_getBalance"))
+
+	mockHead(primary, 111).Once()
+	mockHead(secondary, 111).Once()
+	pool.CheckHealth()
+
+	primary.On("Call", mock.Anything, "eth_getBalance").Return(nil).Once()
+	require.NoError(t, pool.Call(nil, "eth_getBalance"), "fails back to the primary once it catches up")
+
+	primary.AssertExpectations(t)
+	secondary.AssertExpectations(t)
+	inner.AssertExpectations(t)
+}
+
+func TestPool_Call_BroadcastsRawTransactions(t *testing.T) {
+	pool, primary, secondary := newTestPool()
+
+	primary.On("Call", mock.Anything, "eth_sendRawTransaction", "0xf8").Return(errors.New("connection refused")).Once()
+	secondary.On("Call", mock.Anything, "eth_sendRawTransaction", "0xf8").Run(func(args mock.Arguments) {
+		*args.Get(0).(*json.RawMessage) = json.RawMessage(`"0xabc"`)
+	}).Return(nil).Once()
+
+	var hash string
+	require.NoError(t, pool.Call(&hash, "eth_sendRawTransaction", "0xf8"))
+	assert.Equal(t, "0xabc", hash)
+
+	primary.AssertExpectations(t)
+	secondary.AssertExpectations(t)
+}
@@ -0,0 +1,66 @@
+package eth
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// revertReasonSelector is the function selector of Error(string), which
+// solidity's require and revert encode their reason with.
+var revertReasonSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+var revertReasonArguments abi.Arguments
+
+func init() {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	revertReasonArguments = abi.Arguments{{Type: stringType}}
+}
+
+// RevertError is returned when a call or transaction is reverted by the EVM.
+// Reason is empty if the contract did not give one.
+type RevertError struct {
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return "execution reverted"
+	}
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+// DecodeRevertReason decodes the reason from the return data of a call that
+// reverted with Error(string). The second value is false if the data is not
+// a revert reason.
+func DecodeRevertReason(data []byte) (string, bool) {
+	if len(data) < len(revertReasonSelector) || !bytes.Equal(data[:len(revertReasonSelector)], revertReasonSelector) {
+		return "", false
+	}
+	values, err := revertReasonArguments.UnpackValues(data[len(revertReasonSelector):])
+	if err != nil || len(values) != 1 {
+		return "", false
+	}
+	reason, ok := values[0].(string)
+	return reason, ok
+}
+
+// asRevertError returns the RevertError described by an error returned by
+// the node, or nil if the error is not a revert. Geth reports reverts as
+// "execution reverted", followed by the reason if there is one.
+func asRevertError(err error) *RevertError {
+	if err == nil {
+		return nil
+	}
+	const prefix = "execution reverted"
+	msg := err.Error()
+	if !strings.HasPrefix(msg, prefix) {
+		return nil
+	}
+	return &RevertError{Reason: strings.TrimPrefix(strings.TrimPrefix(msg, prefix), ": ")}
+}
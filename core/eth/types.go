@@ -166,10 +166,22 @@ func (h BlockHeader) Hash() common.Hash {
 // TxReceipt holds the block number and the transaction hash of a signed
 // transaction that has been written to the blockchain.
 type TxReceipt struct {
-	BlockNumber *utils.Big   `json:"blockNumber"`
-	BlockHash   *common.Hash `json:"blockHash"`
-	Hash        common.Hash  `json:"transactionHash"`
-	Logs        []Log        `json:"logs"`
+	BlockNumber       *utils.Big      `json:"blockNumber"`
+	BlockHash         *common.Hash    `json:"blockHash"`
+	Hash              common.Hash     `json:"transactionHash"`
+	GasUsed           *utils.Big      `json:"gasUsed,omitempty"`
+	EffectiveGasPrice *utils.Big      `json:"effectiveGasPrice,omitempty"`
+	Status            *hexutil.Uint64 `json:"status,omitempty"`
+	Logs              []Log           `json:"logs"`
+}
+
+// FeeHistory is the result of eth_feeHistory. BaseFeePerGas has one more
+// entry than Reward, being the base fee of the block after the newest one.
+type FeeHistory struct {
+	OldestBlock   *utils.Big     `json:"oldestBlock"`
+	BaseFeePerGas []*utils.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64      `json:"gasUsedRatio"`
+	Reward        [][]*utils.Big `json:"reward"`
 }
 
 // Unconfirmed returns true if the transaction is not confirmed.
@@ -177,6 +189,12 @@ func (txr *TxReceipt) Unconfirmed() bool {
 	return txr.Hash == emptyHash || txr.BlockNumber == nil
 }
 
+// Failed returns true if the transaction was mined but reverted. Receipts of
+// blocks from before the Byzantium fork have no status, and never fail.
+func (txr *TxReceipt) Failed() bool {
+	return !txr.Unconfirmed() && txr.Status != nil && *txr.Status == 0
+}
+
 // ChainlinkFulfilledTopic is the signature for the event emitted after calling
 // ChainlinkClient.validateChainlinkCallback(requestId). See
 // ../../evm-contracts/src/v0.6/ChainlinkClient.sol
@@ -47,6 +47,35 @@ func TestReceipt_UnmarshalEmptyBlockHash(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReceipt_Failed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status string
+		want   bool
+	}{
+		{"succeeded", `"status": "0x1",`, false},
+		{"reverted", `"status": "0x0",`, true},
+		{"pre-byzantium", "", false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			input := `{
+				"transactionHash": "0x444172bef57ad978655171a8af2cfd89baa02a97fcb773067aef7794d6913374",
+				` + test.status + `
+				"blockNumber": "0x8bf99b"
+			}`
+
+			var receipt eth.TxReceipt
+			require.NoError(t, json.Unmarshal([]byte(input), &receipt))
+			assert.Equal(t, test.want, receipt.Failed())
+		})
+	}
+}
+
 func TestModels_HexToFunctionSelector(t *testing.T) {
 	t.Parallel()
 	fid := eth.HexToFunctionSelector("0xb3f98adc")
@@ -237,6 +237,17 @@ func (c *SimulatedBackendClient) GetBlockHeight() (height uint64, err error) {
 	return c.currentBlockNumber().Uint64() - 1, nil
 }
 
+// GetChainHeight returns the height of the simulated blockchain, which the
+// client is always in sync with.
+func (c *SimulatedBackendClient) GetChainHeight() (uint64, error) {
+	return c.GetBlockHeight()
+}
+
+// IsSyncing returns false, as the simulated blockchain is always in sync.
+func (c *SimulatedBackendClient) IsSyncing() (bool, error) {
+	return false, nil
+}
+
 func (c *SimulatedBackendClient) blockNumber(
 	number interface{}) (blockNumber *big.Int, err error) {
 	switch n := number.(type) {
@@ -288,6 +299,19 @@ func (c *SimulatedBackendClient) GetBlockByNumber(hex string) (block eth.Block,
 		Transactions: txs}, nil
 }
 
+// GetBlockHeaderByHash returns the header of the block with the passed hash.
+func (c *SimulatedBackendClient) GetBlockHeaderByHash(hash common.Hash) (*eth.BlockHeader, error) {
+	h, err := c.b.HeaderByHash(context.Background(), hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while retrieving block %s", hash.Hex())
+	}
+	return &eth.BlockHeader{
+		ParentHash: h.ParentHash,
+		Number:     hexutil.Big(*h.Number),
+		GethHash:   h.Hash(),
+	}, nil
+}
+
 // GetChainID returns the ethereum ChainID.
 func (c *SimulatedBackendClient) GetChainID() (*big.Int, error) {
 	// The actual chain ID is c.b.Blockchain().Config().ChainID, but here we need
@@ -295,6 +319,44 @@ func (c *SimulatedBackendClient) GetChainID() (*big.Int, error) {
 	return big.NewInt(int64(c.chainId)), nil
 }
 
+// GetFeeHistory is not supported by the simulated backend, which predates
+// EIP-1559.
+func (c *SimulatedBackendClient) GetFeeHistory(blockCount uint64, rewardPercentiles []float64) (eth.FeeHistory, error) {
+	return eth.FeeHistory{}, fmt.Errorf("SimulatedBackendClient does not support eth_feeHistory")
+}
+
+// EstimateGas returns the simulated backend's estimate of the gas the
+// transaction would use.
+func (c *SimulatedBackendClient) EstimateGas(from, to common.Address, data []byte) (uint64, error) {
+	gas, err := c.b.EstimateGas(context.Background(), ethereum.CallMsg{
+		From: from, To: &to, Data: data})
+	if err != nil {
+		return 0, errors.Wrapf(err, "while estimating gas of call to %s", to)
+	}
+	return gas, nil
+}
+
+// GetRevertReason replays the call on the simulated backend, and decodes the
+// reason from its return data.
+func (c *SimulatedBackendClient) GetRevertReason(args eth.CallArgs, blockNumber *big.Int) (string, error) {
+	msg := ethereum.CallMsg{To: &args.To, Data: args.Data}
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	b, err := c.b.CallContract(context.Background(), msg, blockNumber)
+	if err != nil {
+		return "", errors.Wrapf(err, "while replaying call to %s", args.To)
+	}
+	reason, _ := eth.DecodeRevertReason(b)
+	return reason, nil
+}
+
 // SubscribeToNewHeads registers a subscription for push notifications of new
 // blocks.
 func (c *SimulatedBackendClient) SubscribeToNewHeads(ctx context.Context,
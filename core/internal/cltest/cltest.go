@@ -160,10 +160,13 @@ func NewTestConfig(t testing.TB, options ...interface{}) *TestConfig {
 	// Unique advisory lock is required otherwise all tests will block each other
 	rawConfig.AdvisoryLockID = uniqueRandomID
 
+	rawConfig.Set("BALANCE_MONITOR_ENABLED", false)
 	rawConfig.Set("BRIDGE_RESPONSE_URL", "http://localhost:6688")
 	rawConfig.Set("ETH_CHAIN_ID", 3)
 	rawConfig.Set("CHAINLINK_DEV", true)
 	rawConfig.Set("ETH_GAS_BUMP_THRESHOLD", 3)
+	rawConfig.Set("ETH_NONCE_GAP_REPAIR_THRESHOLD", 0)
+	rawConfig.Set("ETH_SYNC_CHECK_INTERVAL", "0s")
 	rawConfig.Set("MIGRATE_DATABASE", false)
 	rawConfig.Set("MINIMUM_SERVICE_DURATION", "24h")
 	rawConfig.Set("MIN_INCOMING_CONFIRMATIONS", 1)
@@ -429,6 +429,7 @@ func NewServiceAgreementExecutionLog(
 	return eth.Log{
 		Address:     logEmitter,
 		BlockNumber: uint64(blockHeight),
+		BlockHash:   NewHash(),
 		Data:        StringToVersionedLogData0(t, "internalID", serviceAgreementJSON),
 		Topics: []common.Hash{
 			models.ServiceAgreementExecutionLogTopic,
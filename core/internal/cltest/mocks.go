@@ -3,6 +3,7 @@ package cltest
 import (
 	"context"
 	"encoding"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -224,6 +225,11 @@ func assignResult(result, response interface{}) (err error) {
 			}
 		}
 	}()
+	// Results decoded by the caller are sent as JSON, as by an actual node
+	if raw, ok := result.(*json.RawMessage); ok {
+		*raw, err = json.Marshal(response)
+		return err
+	}
 	if unmarshaler, ok := result.(encoding.TextUnmarshaler); ok {
 		switch resp := response.(type) {
 		case encoding.TextMarshaler:
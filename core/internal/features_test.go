@@ -410,6 +410,10 @@ func TestIntegration_ExternalAdapter_RunLogInitiated(t *testing.T) {
 		eth.RegisterSubscription("logs", logs)
 		eth.RegisterSubscription("newHeads", newHeads)
 	})
+	eth.Context("Log Broadcaster backfills logs", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{Number: hexutil.Uint64(1)})
+		eth.Register("eth_getLogs", []ethpkg.Log{})
+	})
 	require.NoError(t, app.Start())
 
 	eaValue := "87698118359"
@@ -672,6 +676,10 @@ func TestIntegration_CreateServiceAgreement(t *testing.T) {
 		eth.Register("eth_getTransactionCount", `0x100`)
 		eth.Register("eth_chainId", app.Store.Config.ChainID())
 	})
+	eth.Context("Log Broadcaster backfills logs", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{Number: hexutil.Uint64(1)})
+		eth.Register("eth_getLogs", []ethpkg.Log{})
+	})
 	assert.NoError(t, app.StartAndConnect())
 	endAt := time.Now().AddDate(0, 10, 0).Round(time.Second).UTC()
 	sa := cltest.CreateServiceAgreementViaWeb(t, app, "fixtures/web/noop_agreement.json", endAt)
@@ -1044,6 +1052,10 @@ func TestIntegration_RandomnessRequest(t *testing.T) {
 	config, cfgCleanup := cltest.NewConfig(t)
 	defer cfgCleanup()
 	eth.Register("eth_chainId", config.ChainID())
+	eth.Context("Log Broadcaster backfills logs", func(eth *cltest.EthMock) {
+		eth.Register("eth_getBlockByNumber", ethpkg.Block{Number: hexutil.Uint64(1)})
+		eth.Register("eth_getLogs", []ethpkg.Log{})
+	})
 	app.Start()
 
 	j := cltest.FixtureCreateJobViaWeb(t, app, "testdata/randomness_job.json")
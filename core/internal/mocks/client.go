@@ -40,6 +40,27 @@ func (_m *Client) Call(result interface{}, method string, args ...interface{}) e
 	return r0
 }
 
+// EstimateGas provides a mock function with given fields: from, to, data
+func (_m *Client) EstimateGas(from common.Address, to common.Address, data []byte) (uint64, error) {
+	ret := _m.Called(from, to, data)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address, []byte) uint64); ok {
+		r0 = rf(from, to, data)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, common.Address, []byte) error); ok {
+		r1 = rf(from, to, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockByNumber provides a mock function with given fields: hex
 func (_m *Client) GetBlockByNumber(hex string) (eth.Block, error) {
 	ret := _m.Called(hex)
@@ -61,6 +82,29 @@ func (_m *Client) GetBlockByNumber(hex string) (eth.Block, error) {
 	return r0, r1
 }
 
+// GetBlockHeaderByHash provides a mock function with given fields: hash
+func (_m *Client) GetBlockHeaderByHash(hash common.Hash) (*eth.BlockHeader, error) {
+	ret := _m.Called(hash)
+
+	var r0 *eth.BlockHeader
+	if rf, ok := ret.Get(0).(func(common.Hash) *eth.BlockHeader); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eth.BlockHeader)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockHeight provides a mock function with given fields:
 func (_m *Client) GetBlockHeight() (uint64, error) {
 	ret := _m.Called()
@@ -82,6 +126,27 @@ func (_m *Client) GetBlockHeight() (uint64, error) {
 	return r0, r1
 }
 
+// GetChainHeight provides a mock function with given fields:
+func (_m *Client) GetChainHeight() (uint64, error) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChainID provides a mock function with given fields:
 func (_m *Client) GetChainID() (*big.Int, error) {
 	ret := _m.Called()
@@ -151,6 +216,27 @@ func (_m *Client) GetEthBalance(address common.Address) (*assets.Eth, error) {
 	return r0, r1
 }
 
+// GetFeeHistory provides a mock function with given fields: blockCount, rewardPercentiles
+func (_m *Client) GetFeeHistory(blockCount uint64, rewardPercentiles []float64) (eth.FeeHistory, error) {
+	ret := _m.Called(blockCount, rewardPercentiles)
+
+	var r0 eth.FeeHistory
+	if rf, ok := ret.Get(0).(func(uint64, []float64) eth.FeeHistory); ok {
+		r0 = rf(blockCount, rewardPercentiles)
+	} else {
+		r0 = ret.Get(0).(eth.FeeHistory)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, []float64) error); ok {
+		r1 = rf(blockCount, rewardPercentiles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLatestBlock provides a mock function with given fields:
 func (_m *Client) GetLatestBlock() (eth.Block, error) {
 	ret := _m.Called()
@@ -216,6 +302,27 @@ func (_m *Client) GetNonce(address common.Address) (uint64, error) {
 	return r0, r1
 }
 
+// GetRevertReason provides a mock function with given fields: args, blockNumber
+func (_m *Client) GetRevertReason(args eth.CallArgs, blockNumber *big.Int) (string, error) {
+	ret := _m.Called(args, blockNumber)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(eth.CallArgs, *big.Int) string); ok {
+		r0 = rf(args, blockNumber)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(eth.CallArgs, *big.Int) error); ok {
+		r1 = rf(args, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTxReceipt provides a mock function with given fields: hash
 func (_m *Client) GetTxReceipt(hash common.Hash) (*eth.TxReceipt, error) {
 	ret := _m.Called(hash)
@@ -239,6 +346,27 @@ func (_m *Client) GetTxReceipt(hash common.Hash) (*eth.TxReceipt, error) {
 	return r0, r1
 }
 
+// IsSyncing provides a mock function with given fields:
+func (_m *Client) IsSyncing() (bool, error) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendRawTx provides a mock function with given fields: bytes
 func (_m *Client) SendRawTx(bytes []byte) (common.Hash, error) {
 	ret := _m.Called(bytes)
@@ -6,6 +6,8 @@ import (
 	common "github.com/ethereum/go-ethereum/common"
 	eth "github.com/smartcontractkit/chainlink/core/services/eth"
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 // LogBroadcaster is an autogenerated mock type for the LogBroadcaster type
@@ -34,11 +36,35 @@ func (_m *LogBroadcaster) AwaitDependents() <-chan struct{} {
 	return r0
 }
 
+// Connect provides a mock function with given fields: head
+func (_m *LogBroadcaster) Connect(head *models.Head) error {
+	ret := _m.Called(head)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Head) error); ok {
+		r0 = rf(head)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DependentReady provides a mock function with given fields:
 func (_m *LogBroadcaster) DependentReady() {
 	_m.Called()
 }
 
+// Disconnect provides a mock function with given fields:
+func (_m *LogBroadcaster) Disconnect() {
+	_m.Called()
+}
+
+// OnNewHead provides a mock function with given fields: head
+func (_m *LogBroadcaster) OnNewHead(head *models.Head) {
+	_m.Called(head)
+}
+
 // Register provides a mock function with given fields: address, listener
 func (_m *LogBroadcaster) Register(address common.Address, listener eth.LogListener) bool {
 	ret := _m.Called(address, listener)
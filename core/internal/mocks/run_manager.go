@@ -3,10 +3,14 @@
 package mocks
 
 import (
+	eth "github.com/smartcontractkit/chainlink/core/eth"
+
 	big "math/big"
 
 	models "github.com/smartcontractkit/chainlink/core/store/models"
 	mock "github.com/stretchr/testify/mock"
+
+	store "github.com/smartcontractkit/chainlink/core/store"
 )
 
 // RunManager is an autogenerated mock type for the RunManager type
@@ -37,6 +41,20 @@ func (_m *RunManager) Cancel(runID *models.ID) (*models.JobRun, error) {
 	return r0, r1
 }
 
+// CancelRemovedLogRuns provides a mock function with given fields: jobSpecID, log
+func (_m *RunManager) CancelRemovedLogRuns(jobSpecID *models.ID, log eth.Log) error {
+	ret := _m.Called(jobSpecID, log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ID, eth.Log) error); ok {
+		r0 = rf(jobSpecID, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: jobSpecID, initiator, creationHeight, runRequest
 func (_m *RunManager) Create(jobSpecID *models.ID, initiator *models.Initiator, creationHeight *big.Int, runRequest *models.RunRequest) (*models.JobRun, error) {
 	ret := _m.Called(jobSpecID, initiator, creationHeight, runRequest)
@@ -83,13 +101,13 @@ func (_m *RunManager) CreateErrored(jobSpecID *models.ID, initiator models.Initi
 	return r0, r1
 }
 
-// ResumeAllConfirming provides a mock function with given fields: currentBlockHeight
-func (_m *RunManager) ResumeAllConfirming(currentBlockHeight *big.Int) error {
-	ret := _m.Called(currentBlockHeight)
+// ResumeAllConfirming provides a mock function with given fields: chain, currentBlockHeight
+func (_m *RunManager) ResumeAllConfirming(chain *store.Store, currentBlockHeight *big.Int) error {
+	ret := _m.Called(chain, currentBlockHeight)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
-		r0 = rf(currentBlockHeight)
+	if rf, ok := ret.Get(0).(func(*store.Store, *big.Int) error); ok {
+		r0 = rf(chain, currentBlockHeight)
 	} else {
 		r0 = ret.Error(0)
 	}
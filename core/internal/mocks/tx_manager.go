@@ -77,6 +77,29 @@ func (_m *TxManager) Call(result interface{}, method string, args ...interface{}
 	return r0
 }
 
+// CancelTx provides a mock function with given fields: hash
+func (_m *TxManager) CancelTx(hash common.Hash) (*models.Tx, error) {
+	ret := _m.Called(hash)
+
+	var r0 *models.Tx
+	if rf, ok := ret.Get(0).(func(common.Hash) *models.Tx); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckAttempt provides a mock function with given fields: txAttempt, blockHeight
 func (_m *TxManager) CheckAttempt(txAttempt *models.TxAttempt, blockHeight uint64) (*eth.TxReceipt, store.AttemptState, error) {
 	ret := _m.Called(txAttempt, blockHeight)
@@ -179,6 +202,29 @@ func (_m *TxManager) CreateTx(to common.Address, data []byte) (*models.Tx, error
 	return r0, r1
 }
 
+// CreateTxFrom provides a mock function with given fields: surrogateID, from, to, data, gasPriceWei, gasLimit
+func (_m *TxManager) CreateTxFrom(surrogateID null.String, from common.Address, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
+	ret := _m.Called(surrogateID, from, to, data, gasPriceWei, gasLimit)
+
+	var r0 *models.Tx
+	if rf, ok := ret.Get(0).(func(null.String, common.Address, common.Address, []byte, *big.Int, uint64) *models.Tx); ok {
+		r0 = rf(surrogateID, from, to, data, gasPriceWei, gasLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(null.String, common.Address, common.Address, []byte, *big.Int, uint64) error); ok {
+		r1 = rf(surrogateID, from, to, data, gasPriceWei, gasLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTxWithEth provides a mock function with given fields: from, to, value
 func (_m *TxManager) CreateTxWithEth(from common.Address, to common.Address, value *assets.Eth) (*models.Tx, error) {
 	ret := _m.Called(from, to, value)
@@ -230,6 +276,66 @@ func (_m *TxManager) Disconnect() {
 	_m.Called()
 }
 
+// EstimateGas provides a mock function with given fields: from, to, data
+func (_m *TxManager) EstimateGas(from common.Address, to common.Address, data []byte) (uint64, error) {
+	ret := _m.Called(from, to, data)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address, []byte) uint64); ok {
+		r0 = rf(from, to, data)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, common.Address, []byte) error); ok {
+		r1 = rf(from, to, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FinalizedHeight provides a mock function with given fields: tag
+func (_m *TxManager) FinalizedHeight(tag models.FinalityTag) (*big.Int, error) {
+	ret := _m.Called(tag)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(models.FinalityTag) *big.Int); ok {
+		r0 = rf(tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(models.FinalityTag) error); ok {
+		r1 = rf(tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAvailableAccount provides a mock function with given fields: from
+func (_m *TxManager) GetAvailableAccount(from common.Address) *store.ManagedAccount {
+	ret := _m.Called(from)
+
+	var r0 *store.ManagedAccount
+	if rf, ok := ret.Get(0).(func(common.Address) *store.ManagedAccount); ok {
+		r0 = rf(from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ManagedAccount)
+		}
+	}
+
+	return r0
+}
+
 // GetBlockByNumber provides a mock function with given fields: hex
 func (_m *TxManager) GetBlockByNumber(hex string) (eth.Block, error) {
 	ret := _m.Called(hex)
@@ -251,6 +357,29 @@ func (_m *TxManager) GetBlockByNumber(hex string) (eth.Block, error) {
 	return r0, r1
 }
 
+// GetBlockHeaderByHash provides a mock function with given fields: hash
+func (_m *TxManager) GetBlockHeaderByHash(hash common.Hash) (*eth.BlockHeader, error) {
+	ret := _m.Called(hash)
+
+	var r0 *eth.BlockHeader
+	if rf, ok := ret.Get(0).(func(common.Hash) *eth.BlockHeader); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eth.BlockHeader)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockHeight provides a mock function with given fields:
 func (_m *TxManager) GetBlockHeight() (uint64, error) {
 	ret := _m.Called()
@@ -272,6 +401,27 @@ func (_m *TxManager) GetBlockHeight() (uint64, error) {
 	return r0, r1
 }
 
+// GetChainHeight provides a mock function with given fields:
+func (_m *TxManager) GetChainHeight() (uint64, error) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChainID provides a mock function with given fields:
 func (_m *TxManager) GetChainID() (*big.Int, error) {
 	ret := _m.Called()
@@ -341,6 +491,27 @@ func (_m *TxManager) GetEthBalance(address common.Address) (*assets.Eth, error)
 	return r0, r1
 }
 
+// GetFeeHistory provides a mock function with given fields: blockCount, rewardPercentiles
+func (_m *TxManager) GetFeeHistory(blockCount uint64, rewardPercentiles []float64) (eth.FeeHistory, error) {
+	ret := _m.Called(blockCount, rewardPercentiles)
+
+	var r0 eth.FeeHistory
+	if rf, ok := ret.Get(0).(func(uint64, []float64) eth.FeeHistory); ok {
+		r0 = rf(blockCount, rewardPercentiles)
+	} else {
+		r0 = ret.Get(0).(eth.FeeHistory)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, []float64) error); ok {
+		r1 = rf(blockCount, rewardPercentiles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLINKBalance provides a mock function with given fields: address
 func (_m *TxManager) GetLINKBalance(address common.Address) (*assets.Link, error) {
 	ret := _m.Called(address)
@@ -429,6 +600,27 @@ func (_m *TxManager) GetNonce(address common.Address) (uint64, error) {
 	return r0, r1
 }
 
+// GetRevertReason provides a mock function with given fields: args, blockNumber
+func (_m *TxManager) GetRevertReason(args eth.CallArgs, blockNumber *big.Int) (string, error) {
+	ret := _m.Called(args, blockNumber)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(eth.CallArgs, *big.Int) string); ok {
+		r0 = rf(args, blockNumber)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(eth.CallArgs, *big.Int) error); ok {
+		r1 = rf(args, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTxReceipt provides a mock function with given fields: hash
 func (_m *TxManager) GetTxReceipt(hash common.Hash) (*eth.TxReceipt, error) {
 	ret := _m.Called(hash)
@@ -452,6 +644,27 @@ func (_m *TxManager) GetTxReceipt(hash common.Hash) (*eth.TxReceipt, error) {
 	return r0, r1
 }
 
+// IsSyncing provides a mock function with given fields:
+func (_m *TxManager) IsSyncing() (bool, error) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NextActiveAccount provides a mock function with given fields:
 func (_m *TxManager) NextActiveAccount() *store.ManagedAccount {
 	ret := _m.Called()
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// BalanceMonitor checks the ETH balance of every key on each new head. It
+// exports the balances as metrics, records them for key selection, and
+// alerts when one falls below BALANCE_MONITOR_ALERT_THRESHOLD.
+type BalanceMonitor interface {
+	store.HeadTrackable
+	GetEthBalance(address common.Address) *assets.Eth
+}
+
+// BalanceAlert is POSTed to BALANCE_MONITOR_WEBHOOK_URL when a key's ETH
+// balance falls below BALANCE_MONITOR_ALERT_THRESHOLD.
+type BalanceAlert struct {
+	Address     common.Address `json:"address"`
+	Balance     *assets.Eth    `json:"balance"`
+	Threshold   *assets.Eth    `json:"threshold"`
+	BlockNumber int64          `json:"blockNumber"`
+}
+
+type balanceMonitor struct {
+	store    *store.Store
+	balances map[common.Address]*assets.Eth
+	alerted  map[common.Address]bool
+	mutex    sync.RWMutex
+	wg       sync.WaitGroup
+}
+
+// NewBalanceMonitor returns a new balance monitor.
+func NewBalanceMonitor(store *store.Store) BalanceMonitor {
+	return &balanceMonitor{
+		store:    store,
+		balances: make(map[common.Address]*assets.Eth),
+		alerted:  make(map[common.Address]bool),
+	}
+}
+
+// Connect checks the balances as soon as the node connects, so that they
+// are known before the next head.
+func (bm *balanceMonitor) Connect(head *models.Head) error {
+	if !bm.store.Config.BalanceMonitorEnabled() {
+		logger.Debug("BalanceMonitor: disabled")
+		return nil
+	}
+	bm.checkBalances(head)
+	return nil
+}
+
+// Disconnect waits for any webhook notifications in flight.
+func (bm *balanceMonitor) Disconnect() {
+	bm.wg.Wait()
+}
+
+// OnNewHead checks the balance of every key.
+func (bm *balanceMonitor) OnNewHead(head *models.Head) {
+	if !bm.store.Config.BalanceMonitorEnabled() {
+		return
+	}
+	bm.checkBalances(head)
+}
+
+// GetEthBalance returns the balance last seen for the address, or nil if it
+// has not been checked.
+func (bm *balanceMonitor) GetEthBalance(address common.Address) *assets.Eth {
+	bm.mutex.RLock()
+	defer bm.mutex.RUnlock()
+	return bm.balances[address]
+}
+
+func (bm *balanceMonitor) checkBalances(head *models.Head) {
+	var blockNumber int64
+	if head != nil {
+		blockNumber = head.Number
+	}
+
+	for _, account := range bm.store.KeyStore.GetAccounts() {
+		balance, err := bm.store.TxManager.GetEthBalance(account.Address)
+		if err != nil {
+			logger.Warnw("BalanceMonitor: unable to fetch balance", "address", account.Address.Hex(), "error", err)
+			continue
+		}
+
+		bm.mutex.Lock()
+		bm.balances[account.Address] = balance
+		bm.mutex.Unlock()
+
+		store.PromUpdateEthBalance(balance, account.Address)
+		if ma := bm.store.TxManager.GetAvailableAccount(account.Address); ma != nil {
+			ma.SetBalance(balance)
+		}
+		bm.checkThreshold(account.Address, balance, blockNumber)
+	}
+}
+
+// checkThreshold alerts once when the balance falls below the threshold, and
+// again only after it has recovered.
+func (bm *balanceMonitor) checkThreshold(address common.Address, balance *assets.Eth, blockNumber int64) {
+	threshold := bm.store.Config.BalanceMonitorAlertThreshold()
+	if threshold.IsZero() {
+		return
+	}
+
+	bm.mutex.Lock()
+	below := balance.Cmp(threshold) < 0
+	wasBelow := bm.alerted[address]
+	bm.alerted[address] = below
+	bm.mutex.Unlock()
+
+	if below && !wasBelow {
+		logger.Warnw("BalanceMonitor: ETH balance is below BALANCE_MONITOR_ALERT_THRESHOLD, fund this key",
+			"address", address.Hex(), "balance", balance, "threshold", threshold, "blockNumber", blockNumber)
+		bm.notify(BalanceAlert{
+			Address:     address,
+			Balance:     balance,
+			Threshold:   threshold,
+			BlockNumber: blockNumber,
+		})
+	} else if !below && wasBelow {
+		logger.Infow("BalanceMonitor: ETH balance is above BALANCE_MONITOR_ALERT_THRESHOLD again",
+			"address", address.Hex(), "balance", balance)
+	}
+}
+
+// notify POSTs the alert to the webhook in the background, so that a slow
+// webhook does not hold up the other head trackables.
+func (bm *balanceMonitor) notify(alert BalanceAlert) {
+	webhookURL := bm.store.Config.BalanceMonitorWebhookURL()
+	if webhookURL.String() == "" {
+		return
+	}
+
+	bm.wg.Add(1)
+	go func() {
+		defer bm.wg.Done()
+		if err := postBalanceAlert(webhookURL.String(), alert, bm.store.Config.DefaultHTTPTimeout().Duration()); err != nil {
+			logger.Errorw("BalanceMonitor: unable to notify webhook", "address", alert.Address.Hex(), "error", err)
+		}
+	}()
+}
+
+func postBalanceAlert(url string, alert BalanceAlert, timeout time.Duration) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return errors.Wrap(err, "marshaling balance alert")
+	}
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceMonitor_OnNewHead_whenDisabledDoesNothing(t *testing.T) {
+	config, _ := cltest.NewConfig(t)
+	config.Set("BALANCE_MONITOR_ENABLED", false)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+	txm := new(mocks.TxManager)
+	store.TxManager = txm
+	bm := services.NewBalanceMonitor(store)
+
+	bm.OnNewHead(cltest.Head(0))
+
+	// No mock calls
+	txm.AssertExpectations(t)
+}
+
+func TestBalanceMonitor_OnNewHead_RecordsBalances(t *testing.T) {
+	config, _ := cltest.NewConfig(t)
+	config.Set("BALANCE_MONITOR_ENABLED", true)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+	_, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, store.KeyStore.Unlock(cltest.Password))
+	address := cltest.GetAccountAddress(t, store)
+
+	ma := strpkg.NewManagedAccount(accounts.Account{Address: address}, 0)
+	txm := new(mocks.TxManager)
+	txm.On("GetEthBalance", address).Return(assets.NewEth(100), nil).Once()
+	txm.On("GetEthBalance", address).Return(nil, errors.New("connection refused")).Once()
+	txm.On("GetAvailableAccount", address).Return(ma)
+	store.TxManager = txm
+	bm := services.NewBalanceMonitor(store)
+
+	assert.Nil(t, bm.GetEthBalance(address))
+
+	bm.OnNewHead(cltest.Head(1))
+	assert.Equal(t, assets.NewEth(100), bm.GetEthBalance(address))
+	assert.Equal(t, assets.NewEth(100), ma.Balance())
+
+	bm.OnNewHead(cltest.Head(2))
+	assert.Equal(t, assets.NewEth(100), bm.GetEthBalance(address), "keeps the last balance seen")
+
+	txm.AssertExpectations(t)
+}
+
+func TestBalanceMonitor_OnNewHead_NotifiesWebhookBelowThreshold(t *testing.T) {
+	alerts := make(chan services.BalanceAlert, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert services.BalanceAlert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	defer server.Close()
+
+	config, _ := cltest.NewConfig(t)
+	config.Set("BALANCE_MONITOR_ENABLED", true)
+	config.Set("BALANCE_MONITOR_ALERT_THRESHOLD", 50)
+	config.Set("BALANCE_MONITOR_WEBHOOK_URL", server.URL)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+	_, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, store.KeyStore.Unlock(cltest.Password))
+	address := cltest.GetAccountAddress(t, store)
+
+	txm := new(mocks.TxManager)
+	txm.On("GetEthBalance", address).Return(assets.NewEth(10), nil).Twice()
+	txm.On("GetEthBalance", address).Return(assets.NewEth(60), nil).Once()
+	txm.On("GetEthBalance", address).Return(assets.NewEth(20), nil).Once()
+	txm.On("GetAvailableAccount", address).Return(nil)
+	store.TxManager = txm
+	bm := services.NewBalanceMonitor(store)
+
+	for i := int64(1); i <= 4; i++ {
+		bm.OnNewHead(cltest.Head(i))
+	}
+	bm.Disconnect()
+
+	require.Len(t, alerts, 2, "alerts once per fall below the threshold")
+	alert := <-alerts
+	assert.Equal(t, address, alert.Address)
+	assert.Equal(t, assets.NewEth(10), alert.Balance)
+	assert.Equal(t, assets.NewEth(50), alert.Threshold)
+	assert.Equal(t, int64(1), alert.BlockNumber)
+	alert = <-alerts
+	assert.Equal(t, assets.NewEth(20), alert.Balance)
+	assert.Equal(t, int64(4), alert.BlockNumber)
+
+	txm.AssertExpectations(t)
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	ethsvc "github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/store"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/gobuffalo/packr"
 	"go.uber.org/multierr"
@@ -46,6 +48,7 @@ type Application interface {
 	ArchiveJob(*models.ID) error
 	AddServiceAgreement(*models.ServiceAgreement) error
 	NewBox() packr.Box
+	SyncStatuses() []services.SyncStatus
 	services.RunManager
 }
 
@@ -59,16 +62,55 @@ type ChainlinkApplication struct {
 	services.RunManager
 	RunQueue                 services.RunQueue
 	JobSubscriber            services.JobSubscriber
+	LogBroadcaster           ethsvc.LogBroadcaster
 	GasUpdater               services.GasUpdater
+	BalanceMonitor           services.BalanceMonitor
 	FluxMonitor              fluxmonitor.Service
 	Scheduler                *services.Scheduler
 	Store                    *store.Store
 	SessionReaper            services.SleeperTask
+	RunReaper                services.RunReaper
 	pendingConnectionResumer *pendingConnectionResumer
+	chains                   []*chainServices
 	shutdownOnce             sync.Once
 	shutdownSignal           gracefulpanic.Signal
 }
 
+// chainServices follow the heads and logs of an additional chain in
+// ETH_CHAINS for the jobs on that chain. The gas updater and balance monitor
+// only run on the primary chain.
+type chainServices struct {
+	store          *store.Store
+	headTracker    *services.HeadTracker
+	logBroadcaster ethsvc.LogBroadcaster
+	jobSubscriber  services.JobSubscriber
+	fluxMonitor    fluxmonitor.Service
+}
+
+func newChainServices(chain *store.Store, runManager services.RunManager) *chainServices {
+	logBroadcaster := newLogBroadcaster(chain)
+	jobSubscriber := services.NewJobSubscriber(chain, runManager, logBroadcaster)
+	headTrackables := []strpkg.HeadTrackable{
+		chain.TxManager,
+		logBroadcaster,
+		jobSubscriber,
+		newPendingConnectionResumer(runManager),
+	}
+	return &chainServices{
+		store:          chain,
+		headTracker:    services.NewHeadTracker(chain, headTrackables),
+		logBroadcaster: logBroadcaster,
+		jobSubscriber:  jobSubscriber,
+		fluxMonitor:    fluxmonitor.New(chain, runManager, logBroadcaster),
+	}
+}
+
+// newLogBroadcaster creates the LogBroadcaster shared by the log initiated
+// and flux monitor jobs of the chain.
+func newLogBroadcaster(chain *store.Store) ethsvc.LogBroadcaster {
+	return ethsvc.NewLogBroadcaster(chain.TxManager, chain.ORM, 10, chain.Config.EthLogBackfillBatchSize())
+}
+
 // NewApplication initializes a new store if one is not already
 // present at the configured root directory (default: ~/.chainlink),
 // the logger at the same directory and returns the Application to
@@ -83,16 +125,20 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 	)
 	runExecutor := services.NewRunExecutor(store, statsPusher)
 	runQueue := services.NewRunQueue(runExecutor)
-	runManager := services.NewRunManager(runQueue, config, store.ORM, statsPusher, store.TxManager, store.Clock)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	runManager := services.NewRunManager(runQueue, config, store.ORM, statsPusher, store.Clock)
+	logBroadcaster := newLogBroadcaster(store)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, logBroadcaster)
 	gasUpdater := services.NewGasUpdater(store)
-	fluxMonitor := fluxmonitor.New(store, runManager)
+	balanceMonitor := services.NewBalanceMonitor(store)
+	fluxMonitor := fluxmonitor.New(store, runManager, logBroadcaster)
 
 	pendingConnectionResumer := newPendingConnectionResumer(runManager)
 
 	app := &ChainlinkApplication{
 		JobSubscriber:            jobSubscriber,
+		LogBroadcaster:           logBroadcaster,
 		GasUpdater:               gasUpdater,
+		BalanceMonitor:           balanceMonitor,
 		FluxMonitor:              fluxMonitor,
 		StatsPusher:              statsPusher,
 		RunManager:               runManager,
@@ -100,6 +146,7 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 		Scheduler:                services.NewScheduler(store, runManager),
 		Store:                    store,
 		SessionReaper:            services.NewStoreReaper(store),
+		RunReaper:                services.NewRunReaper(store),
 		Exiter:                   os.Exit,
 		pendingConnectionResumer: pendingConnectionResumer,
 		shutdownSignal:           shutdownSignal,
@@ -108,6 +155,8 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 	headTrackables := []strpkg.HeadTrackable{
 		gasUpdater,
 		store.TxManager,
+		logBroadcaster,
+		balanceMonitor,
 		jobSubscriber,
 		pendingConnectionResumer,
 	}
@@ -119,6 +168,10 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 	}
 	app.HeadTracker = services.NewHeadTracker(store, headTrackables)
 
+	for _, chain := range store.Chains() {
+		app.chains = append(app.chains, newChainServices(chain, runManager))
+	}
+
 	return app
 }
 
@@ -140,23 +193,49 @@ func (app *ChainlinkApplication) Start() error {
 	}()
 
 	// XXX: Change to exit on first encountered error.
-	return multierr.Combine(
+	merr := multierr.Combine(
 		app.Store.Start(),
 		app.StatsPusher.Start(),
 		app.RunQueue.Start(),
 		app.RunManager.ResumeAllInProgress(),
 		app.FluxMonitor.Start(),
+	)
+
+	// LogBroadcaster deliberately started after FluxMonitor, so that it
+	// subscribes once the flux monitor jobs have registered their contracts.
+	// There are no logs to subscribe to with Ethereum disabled.
+	if !app.Store.Config.EthereumDisabled() {
+		app.LogBroadcaster.Start()
+	}
+
+	return multierr.Combine(
+		merr,
 
 		// HeadTracker deliberately started after
 		// RunManager.ResumeAllInProgress since it Connects JobSubscriber
 		// which leads to writes of JobRuns RunStatus to the db.
 		// https://www.pivotaltracker.com/story/show/162230780
 		app.HeadTracker.Start(),
+		app.startChains(),
 
 		app.Scheduler.Start(),
+		app.RunReaper.Start(),
 	)
 }
 
+// startChains starts following the heads and logs of the additional chains.
+func (app *ChainlinkApplication) startChains() error {
+	var merr error
+	for _, chain := range app.chains {
+		merr = multierr.Append(merr, chain.fluxMonitor.Start())
+		if !chain.store.Config.EthereumDisabled() {
+			chain.logBroadcaster.Start()
+		}
+		merr = multierr.Append(merr, chain.headTracker.Start())
+	}
+	return merr
+}
+
 // Stop allows the application to exit by halting schedules, closing
 // logs, and closing the DB connection.
 func (app *ChainlinkApplication) Stop() error {
@@ -168,10 +247,18 @@ func (app *ChainlinkApplication) Stop() error {
 		app.Scheduler.Stop()
 		merr = multierr.Append(merr, app.HeadTracker.Stop())
 		app.JobSubscriber.Stop()
+		app.LogBroadcaster.Stop()
 		app.FluxMonitor.Stop()
+		for _, chain := range app.chains {
+			merr = multierr.Append(merr, chain.headTracker.Stop())
+			merr = multierr.Append(merr, chain.jobSubscriber.Stop())
+			chain.logBroadcaster.Stop()
+			chain.fluxMonitor.Stop()
+		}
 		app.RunQueue.Stop()
 		app.StatsPusher.Close()
 		merr = multierr.Append(merr, app.SessionReaper.Stop())
+		merr = multierr.Append(merr, app.RunReaper.Stop())
 		merr = multierr.Append(merr, app.Store.Close())
 	})
 	return merr
@@ -186,6 +273,16 @@ func (app *ChainlinkApplication) GetStatsPusher() synchronization.StatsPusher {
 	return app.StatsPusher
 }
 
+// SyncStatuses returns whether the Ethereum node of each chain is in sync,
+// the primary chain first.
+func (app *ChainlinkApplication) SyncStatuses() []services.SyncStatus {
+	statuses := []services.SyncStatus{app.HeadTracker.SyncStatus()}
+	for _, chain := range app.chains {
+		statuses = append(statuses, chain.headTracker.SyncStatus())
+	}
+	return statuses
+}
+
 // WakeSessionReaper wakes up the reaper to do its reaping.
 func (app *ChainlinkApplication) WakeSessionReaper() {
 	app.SessionReaper.WakeUp()
@@ -195,6 +292,9 @@ func (app *ChainlinkApplication) WakeSessionReaper() {
 // an error from adding the job to the store, the job will not be
 // added to the scheduler.
 func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
+	if app.Store.OnChain(job.ChainID) {
+		job.ChainID = nil
+	}
 	err := app.Store.CreateJob(&job)
 	if err != nil {
 		return err
@@ -205,8 +305,9 @@ func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
 	// XXX: Add mechanism to asynchronously communicate when a job spec has
 	// an ethereum interaction error.
 	// https://www.pivotaltracker.com/story/show/170349568
-	logger.ErrorIf(app.FluxMonitor.AddJob(job))
-	logger.ErrorIf(app.JobSubscriber.AddJob(job, nil))
+	jobSubscriber, fluxMonitor := app.jobServices(job.ChainID)
+	logger.ErrorIf(fluxMonitor.AddJob(job))
+	logger.ErrorIf(jobSubscriber.AddJob(job, nil))
 	return nil
 }
 
@@ -214,12 +315,29 @@ func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
 func (app *ChainlinkApplication) ArchiveJob(ID *models.ID) error {
 	_ = app.JobSubscriber.RemoveJob(ID)
 	app.FluxMonitor.RemoveJob(ID)
+	for _, chain := range app.chains {
+		_ = chain.jobSubscriber.RemoveJob(ID)
+		chain.fluxMonitor.RemoveJob(ID)
+	}
 	return app.Store.ArchiveJob(ID)
 }
 
+// jobServices returns the job subscriber and flux monitor of the chain.
+func (app *ChainlinkApplication) jobServices(chainID *utils.Big) (services.JobSubscriber, fluxmonitor.Service) {
+	for _, chain := range app.chains {
+		if chain.store.OnChain(chainID) {
+			return chain.jobSubscriber, chain.fluxMonitor
+		}
+	}
+	return app.JobSubscriber, app.FluxMonitor
+}
+
 // AddServiceAgreement adds a Service Agreement which includes a job that needs
 // to be scheduled.
 func (app *ChainlinkApplication) AddServiceAgreement(sa *models.ServiceAgreement) error {
+	if app.Store.OnChain(sa.JobSpec.ChainID) {
+		sa.JobSpec.ChainID = nil
+	}
 	err := app.Store.CreateServiceAgreement(sa)
 	if err != nil {
 		return err
@@ -230,8 +348,9 @@ func (app *ChainlinkApplication) AddServiceAgreement(sa *models.ServiceAgreement
 	// XXX: Add mechanism to asynchronously communicate when a job spec has
 	// an ethereum interaction error.
 	// https://www.pivotaltracker.com/story/show/170349568
-	logger.ErrorIf(app.FluxMonitor.AddJob(sa.JobSpec))
-	logger.ErrorIf(app.JobSubscriber.AddJob(sa.JobSpec, nil))
+	jobSubscriber, fluxMonitor := app.jobServices(sa.JobSpec.ChainID)
+	logger.ErrorIf(fluxMonitor.AddJob(sa.JobSpec))
+	logger.ErrorIf(jobSubscriber.AddJob(sa.JobSpec, nil))
 	return nil
 }
 
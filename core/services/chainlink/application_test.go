@@ -5,11 +5,13 @@ package chainlink_test
 import (
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tevino/abool"
 )
@@ -66,3 +68,26 @@ func TestChainlinkApplication_resumesPendingConnection_Archived(t *testing.T) {
 	require.NoError(t, app.StartAndConnect())
 	_ = cltest.WaitForJobRunToComplete(t, store, jr)
 }
+
+func TestChainlinkApplication_AddJob_logInitiatedWithEthereumDisabled(t *testing.T) {
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_DISABLED", true)
+	app, cleanup := cltest.NewApplicationWithConfig(t, config, cltest.EthMockRegisterChainID)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	j := cltest.NewJobWithRunLogInitiator()
+	added := make(chan error)
+	go func() {
+		added <- app.AddJob(j)
+	}()
+
+	select {
+	case err := <-added:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("adding a RunLog job with Ethereum disabled did not return")
+	}
+	assert.Empty(t, app.JobSubscriber.Jobs())
+}
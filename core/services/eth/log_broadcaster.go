@@ -1,9 +1,11 @@
 package eth
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/eth"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
 )
 
 //go:generate mockery -name LogBroadcaster -output ../../internal/mocks/ -case=underscore
@@ -23,13 +26,18 @@ import (
 // The LogBroadcaster manages log subscription requests for the Chainlink node.  Instead
 // of creating a new websocket subscription for each request, it multiplexes all subscriptions
 // to all of the relevant contracts over a single connection and forwards the logs to the
-// relevant subscribers.
+// relevant subscribers.  Listeners registered at the zero address receive the logs of
+// every contract, and FilteringLogListeners only receive the logs matching their topics.
+// As a HeadTrackable, it moves the cursors of its listeners forward on each head.
 type LogBroadcaster interface {
 	utils.DependentAwaiter
 	Start()
 	Register(address common.Address, listener LogListener) (connected bool)
 	Unregister(address common.Address, listener LogListener)
 	Stop()
+	Connect(head *models.Head) error
+	Disconnect()
+	OnNewHead(head *models.Head)
 }
 
 // The LogListener responds to log events through HandleLog, and contains setup/tear-down
@@ -41,34 +49,71 @@ type LogListener interface {
 	JobID() *models.ID
 }
 
-type logBroadcaster struct {
-	ethClient     eth.Client
-	orm           *orm.ORM
-	backfillDepth uint64
-	connected     bool
-	started       bool
+// A RemovedLogListener is a LogListener that is also told about the logs it
+// was sent that a chain reorganization removed, through HandleRemovedLog.
+type RemovedLogListener interface {
+	LogListener
+	HandleRemovedLog(lb LogBroadcast)
+}
+
+// A BackfillingLogListener is a LogListener that needs the logs from an earlier
+// block than the broadcaster backfills on its own, such as when the chain is
+// being replayed.  FromBlock is consulted once, when the listener registers.
+type BackfillingLogListener interface {
+	LogListener
+	FromBlock() *big.Int
+}
+
+// A FilteringLogListener is a LogListener that is only sent the logs matching
+// its topic filter, which has the format of ethereum.FilterQuery.Topics.  Topics
+// is consulted once, when the listener registers.
+type FilteringLogListener interface {
+	LogListener
+	Topics() [][]common.Hash
+}
 
-	listeners        map[common.Address]map[LogListener]struct{}
+type logBroadcaster struct {
+	ethClient         eth.Client
+	orm               *orm.ORM
+	backfillDepth     uint64
+	backfillBatchSize uint64
+	connected         bool
+	started           bool
+	cursors           map[string]*models.LogCursor
+	fromBlocks        map[LogListener]uint64
+	chBackfilled      chan struct{}
+
+	listeners        map[common.Address]map[LogListener][][]common.Hash
 	chAddListener    chan registration
 	chRemoveListener chan registration
+	chNewHead        chan models.Head
 
 	utils.DependentAwaiter
 	chStop chan struct{}
 	chDone chan struct{}
 }
 
-// NewLogBroadcaster creates a new instance of the logBroadcaster
-func NewLogBroadcaster(ethClient eth.Client, orm *orm.ORM, backfillDepth uint64) LogBroadcaster {
+// NewLogBroadcaster creates a new instance of the logBroadcaster. On each
+// (re)connection it backfills logs from the earliest block a listener's cursor
+// is at, or from backfillDepth blocks ago for listeners without one, in
+// eth_getLogs calls of at most backfillBatchSize blocks. Cursors move with
+// each log and each head, so only the blocks missed while disconnected are
+// fetched, however long a listener has gone without logs.
+func NewLogBroadcaster(ethClient eth.Client, orm *orm.ORM, backfillDepth, backfillBatchSize uint64) LogBroadcaster {
 	return &logBroadcaster{
-		ethClient:        ethClient,
-		orm:              orm,
-		backfillDepth:    backfillDepth,
-		listeners:        make(map[common.Address]map[LogListener]struct{}),
-		chAddListener:    make(chan registration),
-		chRemoveListener: make(chan registration),
-		chStop:           make(chan struct{}),
-		chDone:           make(chan struct{}),
-		DependentAwaiter: utils.NewDependentAwaiter(),
+		ethClient:         ethClient,
+		orm:               orm,
+		backfillDepth:     backfillDepth,
+		backfillBatchSize: backfillBatchSize,
+		cursors:           make(map[string]*models.LogCursor),
+		fromBlocks:        make(map[LogListener]uint64),
+		listeners:         make(map[common.Address]map[LogListener][][]common.Hash),
+		chAddListener:     make(chan registration),
+		chRemoveListener:  make(chan registration),
+		chNewHead:         make(chan models.Head, 1),
+		chStop:            make(chan struct{}),
+		chDone:            make(chan struct{}),
+		DependentAwaiter:  utils.NewDependentAwaiter(),
 	}
 }
 
@@ -158,14 +203,101 @@ func (b *logBroadcaster) awaitInitialSubscribers() {
 	}
 }
 
+// addresses returns the contracts to subscribe to, or nil for every contract
+// if a listener is registered at the zero address.
 func (b *logBroadcaster) addresses() []common.Address {
+	if _, all := b.listeners[common.Address{}]; all {
+		return nil
+	}
 	var addresses []common.Address
 	for address := range b.listeners {
 		addresses = append(addresses, address)
 	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
 	return addresses
 }
 
+// filterQuery returns the smallest query matching the logs of every listener.
+// At each position, its topics are the union of those of the listeners, or
+// any topic if a listener accepts any.
+func (b *logBroadcaster) filterQuery() ethereum.FilterQuery {
+	var filters [][][]common.Hash
+	for _, listeners := range b.listeners {
+		for _, topics := range listeners {
+			filters = append(filters, topics)
+		}
+	}
+	return ethereum.FilterQuery{
+		Addresses: b.addresses(),
+		Topics:    combineTopics(filters),
+	}
+}
+
+func combineTopics(filters [][][]common.Hash) [][]common.Hash {
+	if len(filters) == 0 {
+		return nil
+	}
+	length := len(filters[0])
+	for _, topics := range filters {
+		if len(topics) < length {
+			length = len(topics)
+		}
+	}
+
+	combined := make([][]common.Hash, length)
+	for i := range combined {
+		union := make(map[common.Hash]struct{})
+		for _, topics := range filters {
+			if len(topics[i]) == 0 {
+				union = nil
+				break
+			}
+			for _, topic := range topics[i] {
+				union[topic] = struct{}{}
+			}
+		}
+		for topic := range union {
+			combined[i] = append(combined[i], topic)
+		}
+		sort.Slice(combined[i], func(j, k int) bool {
+			return bytes.Compare(combined[i][j].Bytes(), combined[i][k].Bytes()) < 0
+		})
+	}
+
+	for length > 0 && len(combined[length-1]) == 0 {
+		length--
+	}
+	if length == 0 {
+		return nil
+	}
+	return combined[:length]
+}
+
+// matchesTopics reports whether the log topics match the topic filter.
+func matchesTopics(filter [][]common.Hash, topics []common.Hash) bool {
+	if len(filter) > len(topics) {
+		return false
+	}
+	for i, wanted := range filter {
+		if len(wanted) == 0 {
+			continue
+		}
+		var found bool
+		for _, topic := range wanted {
+			if topic == topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func (b *logBroadcaster) Stop() {
 	close(b.chStop)
 	if b.started {
@@ -190,6 +322,26 @@ func (b *logBroadcaster) Unregister(address common.Address, listener LogListener
 	}
 }
 
+// Connect is a no-op, as the broadcaster reconnects with its own subscription.
+func (b *logBroadcaster) Connect(head *models.Head) error { return nil }
+
+// Disconnect is a no-op, as the broadcaster reconnects with its own subscription.
+func (b *logBroadcaster) Disconnect() {}
+
+// OnNewHead hands the head to the broadcaster's loop, which moves the cursors
+// forward. If the loop is busy, the previous head is replaced, as only the
+// latest matters.
+func (b *logBroadcaster) OnNewHead(head *models.Head) {
+	select {
+	case <-b.chNewHead:
+	default:
+	}
+	select {
+	case b.chNewHead <- *head:
+	default:
+	}
+}
+
 // The subscription is closed in two cases:
 //   - intentionally, when the set of contracts we're listening to changes
 //   - on a connection error
@@ -251,33 +403,59 @@ func (b *logBroadcaster) backfillLogs() (chBackfilledLogs chan eth.Log, abort bo
 		}
 		currentHeight := uint64(latestBlock.Number)
 
-		// Backfill from `backfillDepth` blocks ago.  It's up to the subscribers to
-		// filter out logs they've already dealt with.
-		fromBlock := currentHeight - b.backfillDepth
-		if fromBlock > currentHeight {
-			fromBlock = 0 // Overflow protection
+		// Backfill from the earliest block a listener's cursor is at, which is
+		// where it left off, or from `backfillDepth` blocks ago for listeners
+		// without a cursor, or from where a newly registered listener asked to
+		// start.  It's up to the subscribers to filter out logs they've already
+		// dealt with.
+		defaultFromBlock := currentHeight - b.backfillDepth
+		if defaultFromBlock > currentHeight {
+			defaultFromBlock = 0 // Overflow protection
 		}
-
-		q := ethereum.FilterQuery{
-			FromBlock: big.NewInt(int64(fromBlock)),
-			Addresses: b.addresses(),
+		fromBlock := currentHeight
+		for _, listenerFromBlock := range b.fromBlocks {
+			if listenerFromBlock < fromBlock {
+				fromBlock = listenerFromBlock
+			}
+		}
+		for address, listeners := range b.listeners {
+			for listener := range listeners {
+				cursor, err := b.cursor(listener, address)
+				if err != nil {
+					return err
+				}
+				listenerFromBlock := defaultFromBlock
+				if cursor != nil && cursor.Initialized {
+					listenerFromBlock = cursor.BlockIndex
+				}
+				if listenerFromBlock < fromBlock {
+					fromBlock = listenerFromBlock
+				}
+			}
 		}
 
-		logs, err := b.ethClient.GetLogs(q)
+		q := b.filterQuery()
+		q.FromBlock = new(big.Int).SetUint64(fromBlock)
+		q.ToBlock = new(big.Int).SetUint64(currentHeight)
+
+		logs, err := eth.GetLogsInBatches(b.ethClient, q, b.backfillBatchSize)
 		if err != nil {
 			return err
 		}
 
+		b.fromBlocks = make(map[LogListener]uint64)
 		chBackfilledLogs = make(chan eth.Log)
-		go b.deliverBackfilledLogs(logs, chBackfilledLogs)
+		b.chBackfilled = make(chan struct{})
+		go b.deliverBackfilledLogs(logs, chBackfilledLogs, b.chBackfilled)
 		return nil
 
 	})
 	return
 }
 
-func (b *logBroadcaster) deliverBackfilledLogs(logs []eth.Log, chBackfilledLogs chan<- eth.Log) {
+func (b *logBroadcaster) deliverBackfilledLogs(logs []eth.Log, chBackfilledLogs chan<- eth.Log, chBackfilled chan<- struct{}) {
 	defer close(chBackfilledLogs)
+	defer close(chBackfilled)
 	for _, log := range logs {
 		select {
 		case chBackfilledLogs <- log:
@@ -323,6 +501,9 @@ func (b *logBroadcaster) process(subscription eth.Subscription, chRawLogs <-chan
 		case r := <-b.chRemoveListener:
 			needsResubscribe = b.onRemoveListener(r) || needsResubscribe
 
+		case head := <-b.chNewHead:
+			b.onNewHead(head)
+
 		case <-debounceResubscribe.C:
 			if needsResubscribe {
 				return true, nil
@@ -338,44 +519,148 @@ func (b *logBroadcaster) process(subscription eth.Subscription, chRawLogs <-chan
 }
 
 func (b *logBroadcaster) onRawLog(rawLog eth.Log) {
-	for listener := range b.listeners[rawLog.Address] {
-		// Ignore duplicate logs sent back due to reorgs
-		if rawLog.Removed {
+	b.broadcast(rawLog.Address, rawLog)
+	if rawLog.Address != (common.Address{}) {
+		b.broadcast(common.Address{}, rawLog)
+	}
+}
+
+// broadcast sends the log to the listeners registered at the address whose
+// topics it matches.
+func (b *logBroadcaster) broadcast(address common.Address, rawLog eth.Log) {
+	for listener, topics := range b.listeners[address] {
+		if !matchesTopics(topics, rawLog.Topics) {
 			continue
 		}
-
 		rawLogCopy := rawLog.Copy()
 		lb := logBroadcast{b.orm, &rawLogCopy, listener.JobID()}
-		listener.HandleLog(&lb, nil)
+		if rawLog.Removed {
+			b.onRemovedLog(listener, &lb)
+		} else {
+			listener.HandleLog(&lb, nil)
+		}
+		b.advanceCursor(listener, address, rawLog)
+	}
+}
+
+// cursor returns the listener's cursor for the contract, or nil if the
+// listener has no job.
+func (b *logBroadcaster) cursor(listener LogListener, address common.Address) (*models.LogCursor, error) {
+	if listener.JobID() == nil {
+		return nil, nil
+	}
+	name := models.ContractLogCursorName(listener.JobID(), address)
+	if cursor, exists := b.cursors[name]; exists {
+		return cursor, nil
+	}
+
+	cursor, err := b.orm.FindLogCursor(name)
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return nil, err
+	}
+	cursor.Name = name
+	b.cursors[name] = &cursor
+	return &cursor, nil
+}
+
+func (b *logBroadcaster) advanceCursor(listener LogListener, address common.Address, rawLog eth.Log) {
+	cursor, err := b.cursor(listener, address)
+	if err != nil {
+		logger.Errorw("Unable to load log cursor", "address", address.Hex(), "err", err)
+		return
+	}
+	if cursor == nil || !cursor.Advance(rawLog) {
+		return
+	}
+	if err := b.orm.SaveLogCursor(cursor); err != nil {
+		logger.Errorw("Unable to save log cursor", "cursor", cursor.Name, "err", err)
+	}
+}
+
+// onNewHead moves the cursor of every listener to backfillDepth blocks below
+// the head, so that a listener without recent logs is not backfilled from its
+// last log after a restart. The margin covers logs still on their way from
+// the subscription. Cursors stay put until the backfilled logs are delivered.
+// The cursors that moved are saved together, in one statement per head.
+func (b *logBroadcaster) onNewHead(head models.Head) {
+	if head.Number < 0 || uint64(head.Number) <= b.backfillDepth {
+		return
+	}
+	if b.chBackfilled != nil {
+		select {
+		case <-b.chBackfilled:
+		default:
+			return
+		}
+	}
+	blockNumber := uint64(head.Number) - b.backfillDepth
+	var names []string
+	for address, listeners := range b.listeners {
+		for listener := range listeners {
+			cursor, err := b.cursor(listener, address)
+			if err != nil {
+				logger.Errorw("Unable to load log cursor", "address", address.Hex(), "err", err)
+				continue
+			}
+			if cursor != nil && cursor.AdvanceToBlock(blockNumber) {
+				names = append(names, cursor.Name)
+			}
+		}
+	}
+	if err := b.orm.AdvanceLogCursorsToBlock(names, blockNumber); err != nil {
+		logger.Errorw("Unable to save log cursors", "blockNumber", blockNumber, "err", err)
+	}
+}
+
+// onRemovedLog forgets that the listener consumed a log removed by a chain
+// reorganization, so it is delivered again should its block rejoin the chain.
+func (b *logBroadcaster) onRemovedLog(listener LogListener, lb *logBroadcast) {
+	if lb.consumerID != nil {
+		if err := b.orm.DeleteLogConsumption(lb.log, lb.consumerID); err != nil {
+			logger.Errorw("Unable to delete consumption of removed log", "jobID", lb.consumerID.String(), "err", err)
+		}
+	}
+	if rl, ok := listener.(RemovedLogListener); ok {
+		rl.HandleRemovedLog(lb)
 	}
 }
 
 func (b *logBroadcaster) onAddListener(r registration) (needsResubscribe bool) {
-	_, knownAddress := b.listeners[r.address]
-	if !knownAddress {
-		b.listeners[r.address] = make(map[LogListener]struct{})
+	previousQuery := b.filterQuery()
+	if _, knownAddress := b.listeners[r.address]; !knownAddress {
+		b.listeners[r.address] = make(map[LogListener][][]common.Hash)
 	}
 	if _, exists := b.listeners[r.address][r.listener]; exists {
 		panic("registration already exists")
 	}
-	b.listeners[r.address][r.listener] = struct{}{}
+	var topics [][]common.Hash
+	if fl, ok := r.listener.(FilteringLogListener); ok {
+		topics = fl.Topics()
+	}
+	b.listeners[r.address][r.listener] = topics
 
-	if !knownAddress {
-		// Recreate the subscription with the new contract address
+	if bl, ok := r.listener.(BackfillingLogListener); ok && bl.FromBlock() != nil {
+		// Recreate the subscription to backfill the listener's earlier logs
+		b.fromBlocks[r.listener] = bl.FromBlock().Uint64()
 		return true
 	}
-	return false
+	// Recreate the subscription if the new contract address or topics widen it
+	return !reflect.DeepEqual(previousQuery, b.filterQuery())
 }
 
 func (b *logBroadcaster) onRemoveListener(r registration) (needsResubscribe bool) {
+	previousQuery := b.filterQuery()
 	r.listener.OnDisconnect()
 	delete(b.listeners[r.address], r.listener)
+	delete(b.fromBlocks, r.listener)
+	if r.listener.JobID() != nil {
+		delete(b.cursors, models.ContractLogCursorName(r.listener.JobID(), r.address))
+	}
 	if len(b.listeners[r.address]) == 0 {
 		delete(b.listeners, r.address)
-		// Recreate the subscription without this contract address
-		return true
 	}
-	return false
+	// Recreate the subscription if it narrows without this contract address or topics
+	return !reflect.DeepEqual(previousQuery, b.filterQuery())
 }
 
 // createSubscription creates a new log subscription starting at the current block.  If previous logs
@@ -387,9 +672,7 @@ func (b *logBroadcaster) createSubscription() (sub ManagedSubscription, abort bo
 	}
 
 	abort = utils.RetryWithBackoff(b.chStop, "creating subscription to Ethereum node", func() error {
-		filterQuery := ethereum.FilterQuery{
-			Addresses: b.addresses(),
-		}
+		filterQuery := b.filterQuery()
 		chRawLogs := make(chan eth.Log)
 
 		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -429,7 +712,7 @@ type decodingLogListener struct {
 	LogListener
 }
 
-var _ LogListener = (*decodingLogListener)(nil)
+var _ FilteringLogListener = (*decodingLogListener)(nil)
 
 // NewDecodingLogListener creates a new decodingLogListener
 func NewDecodingLogListener(codec eth.ContractCodec, nativeLogTypes map[common.Hash]interface{}, innerListener LogListener) LogListener {
@@ -445,6 +728,18 @@ func NewDecodingLogListener(codec eth.ContractCodec, nativeLogTypes map[common.H
 	}
 }
 
+// Topics filters the logs sent to the listener to those of its log types.
+func (l *decodingLogListener) Topics() [][]common.Hash {
+	var eventIDs []common.Hash
+	for eventID := range l.logTypes {
+		eventIDs = append(eventIDs, eventID)
+	}
+	sort.Slice(eventIDs, func(i, j int) bool {
+		return bytes.Compare(eventIDs[i].Bytes(), eventIDs[j].Bytes()) < 0
+	})
+	return [][]common.Hash{eventIDs}
+}
+
 func (l *decodingLogListener) HandleLog(lb LogBroadcast, err error) {
 	if err != nil {
 		l.LogListener.HandleLog(&logBroadcast{}, err)
@@ -75,7 +75,7 @@ func TestLogBroadcaster_AwaitsInitialSubscribersOnStartup(t *testing.T) {
 	ethClient.On("GetLatestBlock").Return(eth.Block{Number: hexutil.Uint64(blockHeight)}, nil)
 	ethClient.On("GetLogs", mock.Anything).Return([]eth.Log{}, nil)
 
-	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10)
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
 	lb.AddDependents(2)
 	lb.Start()
 
@@ -126,7 +126,7 @@ func TestLogBroadcaster_ResubscribesOnAddOrRemoveContract(t *testing.T) {
 		Run(func(mock.Arguments) { unsubscribeCalls++ })
 	sub.On("Err").Return(nil)
 
-	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10)
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
 	lb.Start()
 
 	type registration struct {
@@ -198,7 +198,7 @@ func TestLogBroadcaster_BroadcastsToCorrectRecipients(t *testing.T) {
 	sub.On("Err").Return(nil)
 	sub.On("Unsubscribe").Return()
 
-	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10)
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
 	lb.Start()
 
 	addr1 := cltest.NewAddress()
@@ -341,7 +341,7 @@ func TestLogBroadcaster_Register_ResubscribesToMostRecentlySeenBlock(t *testing.
 	listener1.On("OnDisconnect").Return()
 	listener2.On("OnDisconnect").Return()
 
-	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10)
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
 	lb.Start()                    // Subscribe #1
 	lb.Register(addr1, listener1) // Subscribe #2
 	chRawLogs := <-chchRawLogs
@@ -357,6 +357,61 @@ func TestLogBroadcaster_Register_ResubscribesToMostRecentlySeenBlock(t *testing.
 	sub.AssertExpectations(t)
 }
 
+func TestLogBroadcaster_OnNewHead_BackfillsQuietListenersFromLastHead(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := createJob(t, store)
+	address := cltest.NewAddress()
+	listener := simpleLogListener{func(ethsvc.LogBroadcast, error) {}, job.ID}
+
+	sub := new(mocks.Subscription)
+	sub.On("Unsubscribe").Return()
+	sub.On("Err").Return(nil)
+
+	// The listener receives no logs, but its cursor follows the heads
+	ethClient := new(mocks.Client)
+	ethClient.On("SubscribeToLogs", mock.Anything, mock.Anything, mock.Anything).Return(sub, nil)
+	ethClient.On("GetLatestBlock").Return(eth.Block{Number: hexutil.Uint64(100)}, nil)
+	ethClient.On("GetLogs", mock.Anything).Return(nil, nil)
+
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
+	lb.Start()
+	lb.Register(address, listener)
+
+	name := models.ContractLogCursorName(job.ID, address)
+	require.Eventually(t, func() bool {
+		lb.OnNewHead(cltest.Head(100))
+		cursor, err := store.FindLogCursor(name)
+		return err == nil && cursor.BlockIndex == 90
+	}, 5*time.Second, 10*time.Millisecond)
+	lb.Stop()
+
+	// After a restart, only the blocks since the last head are backfilled
+	chBackfilled := make(chan struct{})
+	ethClient = new(mocks.Client)
+	ethClient.On("SubscribeToLogs", mock.Anything, mock.Anything, mock.Anything).Return(sub, nil)
+	ethClient.On("GetLatestBlock").Return(eth.Block{Number: hexutil.Uint64(500)}, nil)
+	ethClient.On("GetLogs", mock.Anything).
+		Run(func(args mock.Arguments) {
+			query := args.Get(0).(ethereum.FilterQuery)
+			require.Equal(t, big.NewInt(90), query.FromBlock)
+			close(chBackfilled)
+		}).
+		Return(nil, nil).
+		Once()
+
+	lb = ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 1000)
+	lb.Start()
+	lb.Register(address, listener)
+	<-chBackfilled
+	lb.Stop()
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestDecodingLogListener(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -497,7 +552,7 @@ func TestLogBroadcaster_ReceivesAllLogsWhenResubscribing(t *testing.T) {
 			sub.On("Err").Return(nil)
 			sub.On("Unsubscribe").Return()
 
-			lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10)
+			lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
 			lb.Start()
 
 			var recvd []*eth.Log
@@ -641,7 +696,7 @@ func TestLogBroadcaster_InjectsLogConsumptionRecordFunctions(t *testing.T) {
 	sub.On("Err").Return(nil)
 	sub.On("Unsubscribe").Return()
 
-	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10)
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
 	lb.Start()
 
 	listenerCount := 0
@@ -693,7 +748,7 @@ func TestLogBroadcaster_ProcessesLogsFromReorgs(t *testing.T) {
 	sub.On("Unsubscribe").Return()
 	sub.On("Err").Return(nil)
 
-	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10)
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
 	lb.Start()
 
 	blockHash0 := cltest.NewHash()
@@ -741,3 +796,149 @@ func TestLogBroadcaster_ProcessesLogsFromReorgs(t *testing.T) {
 
 	ethClient.AssertExpectations(t)
 }
+
+type removedLogListener struct {
+	simpleLogListener
+	removed chan *eth.Log
+}
+
+func (listener removedLogListener) HandleRemovedLog(lb ethsvc.LogBroadcast) {
+	listener.removed <- lb.Log().(*eth.Log)
+}
+
+func TestLogBroadcaster_ForgetsConsumptionOfRemovedLogs(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+	sub := new(mocks.Subscription)
+
+	chchRawLogs := make(chan chan<- eth.Log, 1)
+	ethClient.On("SubscribeToLogs", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { chchRawLogs <- args.Get(1).(chan<- eth.Log) }).
+		Return(sub, nil).
+		Once()
+	ethClient.On("GetLatestBlock").Return(eth.Block{Number: hexutil.Uint64(0)}, nil)
+	ethClient.On("GetLogs", mock.Anything).Return([]eth.Log{}, nil).Once()
+	sub.On("Unsubscribe").Return()
+	sub.On("Err").Return(nil)
+
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
+	lb.Start()
+
+	received := make(chan *eth.Log, 2)
+	job := createJob(t, store)
+	listener := removedLogListener{
+		simpleLogListener{
+			func(lb ethsvc.LogBroadcast, err error) {
+				require.NoError(t, err)
+				handleLogBroadcast(t, lb)
+				received <- lb.Log().(*eth.Log)
+			},
+			job.ID,
+		},
+		make(chan *eth.Log, 1),
+	}
+	addr := cltest.NewAddress()
+	lb.Register(addr, &listener)
+	chRawLogs := <-chchRawLogs
+
+	log := eth.Log{Address: addr, BlockHash: cltest.NewHash(), BlockNumber: 1, Index: 0}
+	chRawLogs <- log
+	<-received
+	requireLogConsumptionCount(t, store, 1)
+
+	removed := log
+	removed.Removed = true
+	chRawLogs <- removed
+	require.True(t, (<-listener.removed).Removed)
+	requireLogConsumptionCount(t, store, 0)
+
+	// The block rejoins the chain
+	chRawLogs <- log
+	require.Equal(t, log.BlockHash, (<-received).BlockHash)
+	requireLogConsumptionCount(t, store, 1)
+}
+
+type filteringLogListener struct {
+	simpleLogListener
+	topics [][]common.Hash
+}
+
+func (listener filteringLogListener) Topics() [][]common.Hash {
+	return listener.topics
+}
+
+func TestLogBroadcaster_RoutesLogsByTopic(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+	sub := new(mocks.Subscription)
+
+	addr := cltest.NewAddress()
+	topicA := common.Hash{1}
+	topicB := common.Hash{2}
+	topicC := common.Hash{3}
+
+	chchRawLogs := make(chan chan<- eth.Log, 1)
+	var query ethereum.FilterQuery
+	ethClient.On("SubscribeToLogs", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			query = args.Get(2).(ethereum.FilterQuery)
+			chchRawLogs <- args.Get(1).(chan<- eth.Log)
+		}).
+		Return(sub, nil).
+		Once()
+	ethClient.On("GetLatestBlock").
+		Return(eth.Block{Number: hexutil.Uint64(0)}, nil)
+	ethClient.On("GetLogs", mock.Anything).Return([]eth.Log{}, nil).Once()
+	sub.On("Unsubscribe").Return()
+	sub.On("Err").Return(nil)
+
+	lb := ethsvc.NewLogBroadcaster(ethClient, store.ORM, 10, 100)
+	lb.Start()
+
+	var logsA, logsB []*eth.Log
+	listenerA := filteringLogListener{
+		simpleLogListener{
+			func(lb ethsvc.LogBroadcast, err error) {
+				require.NoError(t, err)
+				logsA = append(logsA, lb.Log().(*eth.Log))
+			},
+			createJob(t, store).ID,
+		},
+		[][]common.Hash{{topicA}},
+	}
+	listenerB := filteringLogListener{
+		simpleLogListener{
+			func(lb ethsvc.LogBroadcast, err error) {
+				require.NoError(t, err)
+				logsB = append(logsB, lb.Log().(*eth.Log))
+			},
+			createJob(t, store).ID,
+		},
+		[][]common.Hash{{topicB}, {topicC}},
+	}
+	lb.Register(addr, &listenerA)
+	lb.Register(addr, &listenerB)
+
+	chRawLogs := <-chchRawLogs
+	require.Equal(t, []common.Address{addr}, query.Addresses)
+	require.Equal(t, [][]common.Hash{{topicA, topicB}}, query.Topics)
+
+	chRawLogs <- eth.Log{Address: addr, BlockHash: cltest.NewHash(), Topics: []common.Hash{topicA}}
+	chRawLogs <- eth.Log{Address: addr, BlockHash: cltest.NewHash(), Topics: []common.Hash{topicB, topicC}}
+	chRawLogs <- eth.Log{Address: addr, BlockHash: cltest.NewHash(), Topics: []common.Hash{topicB, topicA}}
+	chRawLogs <- eth.Log{Address: addr, BlockHash: cltest.NewHash(), Topics: []common.Hash{topicC}}
+
+	require.Eventually(t, func() bool { return len(logsA) == 1 && len(logsB) == 1 }, 5*time.Second, 10*time.Millisecond)
+	lb.Stop()
+
+	require.Equal(t, []common.Hash{topicA}, logsA[0].Topics)
+	require.Equal(t, []common.Hash{topicB, topicC}, logsB[0].Topics)
+
+	ethClient.AssertExpectations(t)
+}
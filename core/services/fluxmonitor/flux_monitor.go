@@ -67,16 +67,18 @@ type addEntry struct {
 }
 
 // New creates a service that manages a collection of DeviationCheckers,
-// one per initiator of type InitiatorFluxMonitor for added jobs.
+// one per initiator of type InitiatorFluxMonitor for added jobs. The
+// logBroadcaster must be started after the service, so that it awaits the
+// checkers of the existing jobs.
 func New(
 	store *store.Store,
 	runManager RunManager,
+	logBroadcaster eth.LogBroadcaster,
 ) Service {
 	if store.Config.EthereumDisabled() {
 		return &concreteFluxMonitor{disabled: true}
 	}
 
-	logBroadcaster := eth.NewLogBroadcaster(store.TxManager, store.ORM, 10)
 	return &concreteFluxMonitor{
 		store:          store,
 		runManager:     runManager,
@@ -111,6 +113,9 @@ func (fm *concreteFluxMonitor) Start() error {
 			return true
 		}
 		job := *j
+		if !fm.store.OnChain(job.ChainID) {
+			return true
+		}
 
 		wg.Add(1)
 		go func() {
@@ -125,7 +130,6 @@ func (fm *concreteFluxMonitor) Start() error {
 	}, models.InitiatorFluxMonitor)
 
 	wg.Wait()
-	fm.logBroadcaster.Start()
 
 	return err
 }
@@ -137,7 +141,6 @@ func (fm *concreteFluxMonitor) Stop() {
 		return
 	}
 
-	fm.logBroadcaster.Stop()
 	close(fm.chStop)
 	if fm.started {
 		fm.started = false
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
-	"github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	ethsvc "github.com/smartcontractkit/chainlink/core/services/eth"
@@ -21,7 +20,6 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -48,30 +46,54 @@ func ensureAccount(t *testing.T, store *store.Store) common.Address {
 
 func TestConcreteFluxMonitor_Start_withEthereumDisabled(t *testing.T) {
 	tests := []struct {
-		name        string
-		enabled     bool
-		wantStarted bool
+		name     string
+		disabled bool
 	}{
-		{"enabled", true, false},
-		{"disabled", false, true},
+		{"disabled", true},
+		{"enabled", false},
 	}
 
-	for _, test := range tests {
+	for _, tt := range tests {
+		test := tt
 		t.Run(test.name, func(t *testing.T) {
 			config, cleanup := cltest.NewConfig(t)
 			defer cleanup()
-			config.Config.Set("ETH_DISABLED", test.enabled)
+			config.Config.Set("ETH_DISABLED", test.disabled)
 			store, cleanup := cltest.NewStoreWithConfig(config)
 			defer cleanup()
+
+			job := cltest.NewJobWithFluxMonitorInitiator()
+			require.NoError(t, store.CreateJob(&job))
+			address := job.Initiators[0].Address
+
 			runManager := new(mocks.RunManager)
+			logBroadcaster := new(mocks.LogBroadcaster)
+			registered := make(chan struct{}, 1)
+			if !test.disabled {
+				logBroadcaster.On("AddDependents", 1).Return().Once()
+				logBroadcaster.On("Register", address, mock.Anything).Return(false).Once().Run(func(mock.Arguments) {
+					registered <- struct{}{}
+				})
+				logBroadcaster.On("DependentReady").Return().Once()
+				logBroadcaster.On("Unregister", address, mock.Anything).Return().Once()
+			}
 
-			fm := fluxmonitor.New(store, runManager)
-			logBroadcaster := fm.(fluxmonitor.MockableLogBroadcaster).MockLogBroadcaster()
+			fm := fluxmonitor.New(store, runManager, logBroadcaster)
 
 			err := fm.Start()
 			require.NoError(t, err)
-			defer fm.Stop()
-			assert.Equal(t, test.wantStarted, logBroadcaster.Started)
+			if !test.disabled {
+				cltest.CallbackOrTimeout(t, "checker registered with the log broadcaster", func() {
+					<-registered
+				})
+			}
+			fm.Stop()
+
+			if test.disabled {
+				logBroadcaster.AssertNotCalled(t, "AddDependents", mock.Anything)
+				logBroadcaster.AssertNotCalled(t, "Register", mock.Anything, mock.Anything)
+			}
+			logBroadcaster.AssertExpectations(t)
 		})
 	}
 }
@@ -80,11 +102,6 @@ func TestConcreteFluxMonitor_AddJobRemoveJob(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
 
-	txm := new(mocks.TxManager)
-	store.TxManager = txm
-	txm.On("GetLatestBlock").Return(eth.Block{Number: hexutil.Uint64(123)}, nil)
-	txm.On("GetLogs", mock.Anything).Return([]eth.Log{}, nil)
-
 	t.Run("starts and stops DeviationCheckers when jobs are added and removed", func(t *testing.T) {
 		job := cltest.NewJobWithFluxMonitorInitiator()
 		runManager := new(mocks.RunManager)
@@ -97,7 +114,7 @@ func TestConcreteFluxMonitor_AddJobRemoveJob(t *testing.T) {
 
 		checkerFactory := new(mocks.DeviationCheckerFactory)
 		checkerFactory.On("New", job.Initiators[0], runManager, store.ORM, store.Config.DefaultHTTPTimeout()).Return(dc, nil)
-		fm := fluxmonitor.New(store, runManager)
+		fm := fluxmonitor.New(store, runManager, new(mocks.LogBroadcaster))
 		fluxmonitor.ExportedSetCheckerFactory(fm, checkerFactory)
 		require.NoError(t, fm.Start())
 
@@ -129,7 +146,7 @@ func TestConcreteFluxMonitor_AddJobRemoveJob(t *testing.T) {
 		job := cltest.NewJobWithRunLogInitiator()
 		runManager := new(mocks.RunManager)
 		checkerFactory := new(mocks.DeviationCheckerFactory)
-		fm := fluxmonitor.New(store, runManager)
+		fm := fluxmonitor.New(store, runManager, new(mocks.LogBroadcaster))
 		fluxmonitor.ExportedSetCheckerFactory(fm, checkerFactory)
 
 		err := fm.Start()
@@ -29,6 +29,13 @@ var (
 	},
 		[]string{"percentile", "block_num"},
 	)
+
+	promGasUpdaterSetDynamicFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_set_dynamic_fee",
+		Help: "Gas updater set EIP-1559 fees (in Wei), by base fee, tip cap and fee cap",
+	},
+		[]string{"fee"},
+	)
 )
 
 // GasUpdater listens for new heads and updates the base gas price dynamically
@@ -85,6 +92,10 @@ func (gu *gasUpdater) OnNewHead(head *models.Head) {
 	if !gu.store.Config.GasUpdaterEnabled() {
 		return
 	}
+	if gu.store.Config.EthEIP1559() {
+		gu.updateDynamicFees()
+		return
+	}
 	blockToFetch := head.Number - gu.blockDelay
 	if blockToFetch < 0 {
 		logger.Warnf("GasUpdater: skipping gas calculation, current block height %v is lower than GAS_UPDATER_BLOCK_DELAY of %v", head.Number, gu.blockDelay)
@@ -142,6 +153,59 @@ func (gu *gasUpdater) setPercentileGasPrice(gasPrice int64) error {
 	return gu.store.Config.SetEthGasPriceDefault(bigGasPrice)
 }
 
+// updateDynamicFees sets the default EIP-1559 fees from the fee history of
+// the last GAS_UPDATER_BLOCK_HISTORY_SIZE blocks. The tip cap is the median of
+// each block's GAS_UPDATER_TRANSACTION_PERCENTILE priority fee, and the fee
+// cap allows for the base fee doubling, which takes at least six full blocks,
+// before the transaction is mined.
+func (gu *gasUpdater) updateDynamicFees() {
+	history, err := gu.store.TxManager.GetFeeHistory(uint64(gu.rollingBlockHistorySize), []float64{float64(gu.percentile)})
+	if err != nil {
+		logger.Error(err, "GasUpdater: error retrieving fee history")
+		return
+	}
+	if len(history.BaseFeePerGas) == 0 {
+		logger.Warn("GasUpdater: fee history has no base fees, is EIP-1559 active on this chain?")
+		return
+	}
+	nextBaseFee := history.BaseFeePerGas[len(history.BaseFeePerGas)-1].ToInt()
+
+	tips := make([]*big.Int, 0, len(history.Reward))
+	for _, rewards := range history.Reward {
+		if len(rewards) > 0 && rewards[0] != nil {
+			tips = append(tips, rewards[0].ToInt())
+		}
+	}
+	tipCap := gu.store.Config.EthGasTipCapDefault()
+	if len(tips) > 0 {
+		sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+		tipCap = tips[len(tips)/2]
+	}
+
+	feeCap := new(big.Int).Add(new(big.Int).Mul(nextBaseFee, big.NewInt(2)), tipCap)
+	if maxGasPrice := gu.store.Config.EthMaxGasPriceWei(); feeCap.Cmp(maxGasPrice) > 0 {
+		logger.Warnw("GasUpdater: capping fee cap at EthMaxGasPriceWei", "feeCap", feeCap, "ethMaxGasPriceWei", maxGasPrice)
+		feeCap = maxGasPrice
+	}
+	if tipCap.Cmp(feeCap) > 0 {
+		logger.Errorf("GasUpdater: cannot set tip cap %v because it exceeds the fee cap %v", tipCap, feeCap)
+		return
+	}
+
+	logger.Debugw("GasUpdater: setting new default EIP-1559 fees", "baseFee", nextBaseFee, "tipCap", tipCap, "feeCap", feeCap)
+	if err := gu.store.Config.SetEthGasTipCapDefault(tipCap); err != nil {
+		logger.Error("GasUpdater error setting tip cap: ", err)
+		return
+	}
+	if err := gu.store.Config.SetEthGasFeeCapDefault(feeCap); err != nil {
+		logger.Error("GasUpdater error setting fee cap: ", err)
+		return
+	}
+	promGasUpdaterSetDynamicFee.WithLabelValues("base_fee").Set(float64(nextBaseFee.Int64()))
+	promGasUpdaterSetDynamicFee.WithLabelValues("tip_cap").Set(float64(tipCap.Int64()))
+	promGasUpdaterSetDynamicFee.WithLabelValues("fee_cap").Set(float64(feeCap.Int64()))
+}
+
 func (gu *gasUpdater) RollingBlockHistory() []eth.Block {
 	return gu.rollingBlockHistory
 }
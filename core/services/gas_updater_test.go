@@ -6,9 +6,11 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -170,3 +172,28 @@ func TestGasUpdater_OnNewHead_WillNotSetGasHigherThanEthMaxGasPriceWei(t *testin
 
 	assert.Equal(t, big.NewInt(42), config.EthGasPriceDefault())
 }
+
+func TestGasUpdater_OnNewHead_EIP1559SetsTipAndFeeCapsFromFeeHistory(t *testing.T) {
+	config, _ := cltest.NewConfig(t)
+	config.Set("GAS_UPDATER_ENABLED", "true")
+	config.Set("GAS_UPDATER_BLOCK_HISTORY_SIZE", "3")
+	config.Set("ETH_EIP1559", "true")
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	config.SetRuntimeStore(store.ORM)
+	defer cleanup()
+	txm := new(mocks.TxManager)
+	store.TxManager = txm
+	gu := services.NewGasUpdater(store)
+
+	history := eth.FeeHistory{
+		BaseFeePerGas: []*utils.Big{utils.NewBig(big.NewInt(90)), utils.NewBig(big.NewInt(95)), utils.NewBig(big.NewInt(98)), utils.NewBig(big.NewInt(100))},
+		Reward:        [][]*utils.Big{{utils.NewBig(big.NewInt(3))}, {utils.NewBig(big.NewInt(1))}, {utils.NewBig(big.NewInt(2))}},
+	}
+	txm.On("GetFeeHistory", uint64(3), []float64{35}).Return(history, nil)
+
+	gu.OnNewHead(cltest.Head(10))
+
+	assert.Equal(t, big.NewInt(2), config.EthGasTipCapDefault())
+	assert.Equal(t, big.NewInt(202), config.EthGasFeeCapDefault())
+	txm.AssertExpectations(t)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -13,9 +14,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	null "gopkg.in/guregu/null.v3"
 )
 
 var (
@@ -23,6 +26,26 @@ var (
 		Name: "head_tracker_heads_received",
 		Help: "The total number of heads seen",
 	})
+	numberReorgs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "head_tracker_reorgs",
+		Help: "The total number of chain reorganizations seen",
+	})
+	headLatency = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "head_tracker_head_latency_seconds",
+		Help: "The time between the timestamp of the latest head and its receipt",
+	})
+	ethNodeLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_eth_node_lag_seconds",
+		Help: "How far the timestamp of the latest head is behind wall-clock time",
+	},
+		[]string{"chain_id"},
+	)
+	ethNodeInSync = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_eth_node_in_sync",
+		Help: "Whether the Ethereum node is connected and in sync with the chain",
+	},
+		[]string{"chain_id"},
+	)
 )
 
 // HeadTracker holds and stores the latest block number experienced by this particular node
@@ -35,7 +58,9 @@ type HeadTracker struct {
 	store                 *strpkg.Store
 	head                  *models.Head
 	headMutex             sync.RWMutex
+	subscribed            bool
 	connected             bool
+	syncing               bool
 	sleeper               utils.Sleeper
 	done                  chan struct{}
 	started               bool
@@ -98,10 +123,8 @@ func (ht *HeadTracker) Stop() error {
 		return nil
 	}
 
-	if ht.connected {
-		ht.connected = false
-		ht.disconnect()
-	}
+	ht.subscribed = false
+	ht.setInSync(false)
 	logger.Info(fmt.Sprintf("Head tracker disconnecting from %v", ht.store.Config.EthereumURL()))
 	close(ht.done)
 	close(ht.subscriptionSucceeded)
@@ -129,7 +152,19 @@ func (ht *HeadTracker) Save(n *models.Head) error {
 		msg := fmt.Sprintf("Cannot save new head confirmation %v because it's equal to or less than current head %v with hash %s", n, ht.head, n.Hash.Hex())
 		return errBlockNotLater{msg}
 	}
-	return ht.store.CreateHead(n)
+	return ht.createHead(n)
+}
+
+// createHead persists the head, then trims the saved heads of its chain to
+// ETH_HEAD_HISTORY_DEPTH.
+func (ht *HeadTracker) createHead(head *models.Head) error {
+	if err := ht.store.CreateHead(head); err != nil {
+		return err
+	}
+	if err := ht.store.TrimOldHeads(head.ChainID, ht.store.Config.EthHeadHistoryDepth()); err != nil {
+		logger.Errorw("Unable to trim old heads", "chainID", head.ChainID, "err", err)
+	}
+	return nil
 }
 
 // Head returns the latest block header being tracked, or nil.
@@ -140,7 +175,8 @@ func (ht *HeadTracker) Head() *models.Head {
 	return ht.head
 }
 
-// Connected returns whether or not this HeadTracker is connected.
+// Connected returns whether or not this HeadTracker is connected to an
+// Ethereum node that is in sync.
 func (ht *HeadTracker) Connected() bool {
 	ht.headMutex.RLock()
 	defer ht.headMutex.RUnlock()
@@ -166,11 +202,33 @@ func (ht *HeadTracker) onNewHead(head *models.Head) {
 	ht.headMutex.Lock()
 	defer ht.headMutex.Unlock()
 
+	if ht.subscribed {
+		ht.updateSync(ht.syncing)
+	}
+	if !ht.connected {
+		return
+	}
+
 	for _, trackable := range ht.callbacks {
 		trackable.OnNewHead(head)
 	}
 }
 
+func (ht *HeadTracker) onReorg(ancestor, head *models.Head) {
+	ht.headMutex.Lock()
+	defer ht.headMutex.Unlock()
+
+	if !ht.connected {
+		return
+	}
+
+	for _, trackable := range ht.callbacks {
+		if rt, ok := trackable.(strpkg.ReorgTrackable); ok {
+			rt.OnReorg(ancestor, head)
+		}
+	}
+}
+
 func (ht *HeadTracker) listenForNewHeads() {
 	defer ht.listenForNewHeadsWg.Done()
 	defer ht.unsubscribeFromHead()
@@ -211,21 +269,46 @@ func (ht *HeadTracker) subscribe() bool {
 }
 
 func (ht *HeadTracker) receiveHeaders() error {
+	var syncCheck <-chan time.Time
+	if interval := ht.store.Config.EthSyncCheckInterval().Duration(); interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		syncCheck = ticker.C
+	}
+
 	for {
 		select {
 		case <-ht.done:
 			return nil
+		case <-syncCheck:
+			ht.checkSync()
 		case block, open := <-ht.headers:
 			if !open {
 				return errors.New("HeadTracker headers prematurely closed")
 			}
 			head := models.NewHead(block.Number.ToInt(), block.Hash())
+			head.ParentHash = block.ParentHash
+			head.ChainID = ht.store.ChainID
+			if block.Time.ToInt().Sign() > 0 {
+				head.Timestamp = null.TimeFrom(time.Unix(block.Time.ToInt().Int64(), 0))
+				headLatency.Set(time.Since(head.Timestamp.Time).Seconds())
+			}
 			logger.Debugw(
 				fmt.Sprintf("Received new head %v", presenters.FriendlyBigInt(head.ToInt())),
 				"blockHeight", head.ToInt(),
 				"blockHash", block.Hash(),
 				"hash", head.Hash)
-			if err := ht.Save(head); err != nil {
+			ancestor, err := ht.findCommonAncestor(ht.Head(), head)
+			if err != nil {
+				logger.Errorw("Unable to check new head for a chain reorganization", "blockHeight", head.Number, "err", err)
+			}
+			if ancestor != nil {
+				if err := ht.reorg(ancestor, head); err != nil {
+					logger.Error(err)
+				} else {
+					ht.onNewHead(head)
+				}
+			} else if err := ht.Save(head); err != nil {
 				switch err.(type) {
 				case errBlockNotLater:
 					logger.Warn(err)
@@ -243,6 +326,79 @@ func (ht *HeadTracker) receiveHeaders() error {
 	}
 }
 
+// findCommonAncestor walks back the parents of head until it reaches a block
+// the tracker saved a head for. It returns that block if head does not extend
+// the current chain, and nil if it does. Reorganizations deeper than the saved
+// heads return the block just below the oldest one. Heads without a parent
+// hash, or further ahead of the current head than the saved heads go back,
+// can't be checked.
+func (ht *HeadTracker) findCommonAncestor(current, head *models.Head) (*models.Head, error) {
+	depth := int64(ht.store.Config.EthHeadHistoryDepth())
+	if current == nil || head.ParentHash == (common.Hash{}) || head.ParentHash == current.Hash || head.Number-current.Number > depth {
+		return nil, nil
+	}
+
+	saved, err := ht.store.HeadsOnChain(ht.store.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	hashes := map[int64]common.Hash{}
+	oldest := current.Number
+	for _, h := range saved {
+		hashes[h.Number] = h.Hash
+		if h.Number < oldest {
+			oldest = h.Number
+		}
+	}
+	if hash, ok := hashes[head.Number]; ok && hash == head.Hash {
+		return nil, nil
+	}
+
+	number, parentHash := head.Number-1, head.ParentHash
+	for ; number >= oldest; number-- {
+		parent, err := ht.store.TxManager.GetBlockHeaderByHash(parentHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fetching block %d", number)
+		}
+		if hash, ok := hashes[number]; ok && hash == parent.Hash() {
+			if number == current.Number {
+				return nil, nil
+			}
+			return &models.Head{Number: number, Hash: hash, ChainID: ht.store.ChainID}, nil
+		}
+		parentHash = parent.ParentHash
+	}
+	return &models.Head{Number: number, Hash: parentHash, ChainID: ht.store.ChainID}, nil
+}
+
+// reorg replaces the heads saved above the common ancestor with the head of
+// the new chain, and lets the trackables revert their work on the orphaned
+// blocks.
+func (ht *HeadTracker) reorg(ancestor, head *models.Head) error {
+	numberReorgs.Inc()
+	logger.Warnw(
+		fmt.Sprintf("Chain reorganization detected, blocks after %v were replaced", ancestor.Number),
+		"ancestor", ancestor.Number,
+		"ancestorHash", ancestor.Hash,
+		"blockHeight", head.Number,
+		"hash", head.Hash)
+
+	if err := ht.store.DeleteHeadsOnChainAfter(ht.store.ChainID, ancestor.Number); err != nil {
+		return errors.Wrap(err, "deleting orphaned heads")
+	}
+	if err := ht.createHead(head); err != nil {
+		return err
+	}
+
+	ht.headMutex.Lock()
+	copy := *head
+	ht.head = &copy
+	ht.headMutex.Unlock()
+
+	ht.onReorg(ancestor, head)
+	return nil
+}
+
 func (ht *HeadTracker) subscribeToHead() error {
 	ht.headMutex.Lock()
 	defer ht.headMutex.Unlock()
@@ -259,29 +415,155 @@ func (ht *HeadTracker) subscribeToHead() error {
 	}
 
 	ht.headSubscription = sub
-	ht.connected = true
-	ht.connect(ht.head)
+	ht.subscribed = true
+	if ht.store.Config.EthSyncCheckInterval().Duration() == 0 {
+		ht.setInSync(true)
+		return nil
+	}
+	syncing, err := ht.store.TxManager.IsSyncing()
+	if err != nil {
+		logger.Warnw("Unable to check whether the Ethereum node is syncing", "err", err)
+	}
+	ht.updateSync(syncing)
 	return nil
 }
 
+// checkSync pauses the trackables, and so runs talking to the chain, while
+// the Ethereum node is syncing or the latest head is older than
+// ETH_MAX_HEAD_AGE, and resumes them once it has caught up. It is called
+// every ETH_SYNC_CHECK_INTERVAL, while the age of the latest head is also
+// checked on each new head, without calling the node.
+func (ht *HeadTracker) checkSync() {
+	if ht.store.Config.EthSyncCheckInterval().Duration() == 0 {
+		return
+	}
+	syncing, err := ht.store.TxManager.IsSyncing()
+
+	ht.headMutex.Lock()
+	defer ht.headMutex.Unlock()
+
+	if err != nil {
+		logger.Warnw("Unable to check whether the Ethereum node is syncing", "err", err)
+		syncing = ht.syncing
+	}
+	if ht.subscribed {
+		ht.updateSync(syncing)
+	}
+}
+
+// updateSync records whether the node is syncing, and connects or
+// disconnects the trackables accordingly.
+func (ht *HeadTracker) updateSync(syncing bool) {
+	ht.syncing = syncing
+	inSync := !syncing && !ht.behind()
+	if !inSync {
+		logger.Warnw("Ethereum node is out of sync, pausing runs until it catches up",
+			"syncing", syncing, "lag", ht.lag())
+	} else if !ht.connected {
+		logger.Info("Ethereum node is in sync")
+	}
+	ht.setInSync(inSync)
+}
+
+// setInSync connects the trackables once the node is in sync, and
+// disconnects them when it falls out of sync.
+func (ht *HeadTracker) setInSync(inSync bool) {
+	ethNodeInSync.WithLabelValues(ht.chainLabel()).Set(boolToFloat(inSync))
+	ethNodeLag.WithLabelValues(ht.chainLabel()).Set(ht.lag().Seconds())
+	if inSync == ht.connected {
+		return
+	}
+	ht.connected = inSync
+	if inSync {
+		ht.connect(ht.head)
+	} else {
+		ht.disconnect()
+	}
+}
+
+// lag is how far the timestamp of the latest head is behind wall-clock time,
+// zero if its timestamp is unknown.
+func (ht *HeadTracker) lag() time.Duration {
+	if ht.head == nil || !ht.head.Timestamp.Valid {
+		return 0
+	}
+	return time.Since(ht.head.Timestamp.Time)
+}
+
+// behind returns whether the latest head is older than ETH_MAX_HEAD_AGE.
+func (ht *HeadTracker) behind() bool {
+	maxAge := ht.store.Config.EthMaxHeadAge().Duration()
+	return maxAge > 0 && ht.lag() > maxAge
+}
+
+func (ht *HeadTracker) chainLabel() string {
+	return ht.store.Config.ChainID().String()
+}
+
+// SyncStatus is whether the Ethereum node of a chain is in sync.
+type SyncStatus struct {
+	ChainID *big.Int
+	InSync  bool
+	Syncing bool
+	Lag     time.Duration
+}
+
+// SyncStatus returns whether the node is subscribed to heads and in sync,
+// and how far behind the chain it is.
+func (ht *HeadTracker) SyncStatus() SyncStatus {
+	ht.headMutex.RLock()
+	defer ht.headMutex.RUnlock()
+
+	return SyncStatus{
+		ChainID: ht.store.Config.ChainID(),
+		InSync:  ht.connected,
+		Syncing: ht.syncing,
+		Lag:     ht.lag(),
+	}
+}
+
 func (ht *HeadTracker) unsubscribeFromHead() error {
 	ht.headMutex.Lock()
 	defer ht.headMutex.Unlock()
 
-	if !ht.connected {
+	if !ht.subscribed {
 		return nil
 	}
 
 	timedUnsubscribe(ht.headSubscription)
 
-	ht.connected = false
-	ht.disconnect()
+	ht.subscribed = false
+	ht.setInSync(false)
 	close(ht.headers)
 	return nil
 }
 
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// timedUnsubscribe attempts to unsubscribe but aborts abruptly after a time delay
+// unblocking the application. This is an effort to mitigate the occasional
+// indefinite block described here from go-ethereum:
+// https://chainlink/pull/600#issuecomment-426320971
+func timedUnsubscribe(unsubscriber Unsubscriber) {
+	unsubscribed := make(chan struct{})
+	go func() {
+		unsubscriber.Unsubscribe()
+		close(unsubscribed)
+	}()
+	select {
+	case <-unsubscribed:
+	case <-time.After(100 * time.Millisecond):
+		logger.Warnf("Subscription %T Unsubscribe timed out.", unsubscriber)
+	}
+}
+
 func (ht *HeadTracker) updateHeadFromDb() error {
-	number, err := ht.store.LastHead()
+	number, err := ht.store.LastHeadOnChain(ht.store.ChainID)
 	if err != nil {
 		return err
 	}
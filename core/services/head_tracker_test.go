@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -37,22 +38,27 @@ func TestHeadTracker_New(t *testing.T) {
 	assert.Equal(t, last.Number, ht.Head().Number)
 }
 
-func TestHeadTracker_New_Limit_At_100(t *testing.T) {
+func TestHeadTracker_Save_TrimsToHeadHistoryDepth(t *testing.T) {
 	t.Parallel()
 
-	store, cleanup := cltest.NewStore(t)
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_HEAD_HISTORY_DEPTH", 50)
+	store, cleanup := cltest.NewStoreWithConfig(config)
 	defer cleanup()
 
 	cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID)
 
-	for idx := 0; idx <= 200; idx++ {
+	for idx := 0; idx < 200; idx++ {
 		assert.Nil(t, store.CreateHead(cltest.Head(idx)))
 	}
-	firstHead, err := store.FirstHead()
-	assert.Nil(t, err)
-	assert.Equal(t, big.NewInt(101), firstHead.ToInt())
 	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{})
 	assert.Nil(t, ht.Start())
+	require.NoError(t, ht.Save(cltest.Head(200)))
+
+	firstHead, err := store.FirstHead()
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(151), firstHead.ToInt())
 	assert.Equal(t, big.NewInt(200), ht.Head().ToInt())
 }
 
@@ -212,3 +218,126 @@ func TestHeadTracker_StartConnectsFromLastSavedHeader(t *testing.T) {
 	g.Eventually(func() *big.Int { return ht.Head().ToInt() }).Should(gomega.Equal(currentBN))
 	assert.NoError(t, ht.Stop())
 }
+
+type reorgTrackable struct {
+	cltest.MockHeadTrackable
+	ancestor atomic.Value
+}
+
+func (r *reorgTrackable) OnReorg(ancestor *models.Head, head *models.Head) {
+	r.ancestor.Store(*ancestor)
+}
+
+func TestHeadTracker_DetectsReorg(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	mocketh := cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID)
+	headers := make(chan eth.BlockHeader)
+	mocketh.RegisterSubscription("newHeads", headers)
+
+	checker := &reorgTrackable{}
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{checker}, cltest.NeverSleeper{})
+
+	var saved []*models.Head
+	for i := int64(1); i <= 3; i++ {
+		head := models.NewHead(big.NewInt(i), cltest.NewHash())
+		if i > 1 {
+			head.ParentHash = saved[i-2].Hash
+		}
+		require.NoError(t, ht.Save(head))
+		saved = append(saved, head)
+	}
+
+	// Block 2 was replaced, and the new block 3 builds on it
+	newParent := eth.BlockHeader{Number: cltest.BigHexInt(2), ParentHash: saved[0].Hash, ParityHash: cltest.NewHash()}
+	mocketh.Register("eth_getBlockByHash", newParent)
+	mocketh.Register("eth_getBlockByHash", eth.BlockHeader{Number: cltest.BigHexInt(1), ParityHash: saved[0].Hash})
+
+	require.NoError(t, ht.Start())
+	g.Eventually(func() int32 { return checker.ConnectedCount() }).Should(gomega.Equal(int32(1)))
+
+	newHead := eth.BlockHeader{Number: cltest.BigHexInt(3), ParentHash: newParent.Hash(), ParityHash: cltest.NewHash()}
+	headers <- newHead
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(1)))
+
+	ancestor := checker.ancestor.Load().(models.Head)
+	assert.Equal(t, int64(1), ancestor.Number)
+	assert.Equal(t, saved[0].Hash, ancestor.Hash)
+	assert.Equal(t, newHead.Hash(), ht.Head().Hash)
+
+	heads, err := store.HeadsOnChain(nil)
+	require.NoError(t, err)
+	require.Len(t, heads, 2)
+	assert.Equal(t, newHead.Hash(), heads[0].Hash)
+	assert.Equal(t, saved[0].Hash, heads[1].Hash)
+	assert.NoError(t, ht.Stop())
+}
+
+func TestHeadTracker_PausesWhileEthNodeIsSyncing(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_SYNC_CHECK_INTERVAL", "100ms")
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+	// Lenient, as sync checks failing in between keep the last known state
+	mocketh := cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID, cltest.LenientEthMock)
+	headers := make(chan eth.BlockHeader)
+	mocketh.RegisterSubscription("newHeads", headers)
+	mocketh.Register("eth_syncing", map[string]string{"currentBlock": "0x1", "highestBlock": "0x10"})
+
+	checker := &cltest.MockHeadTrackable{}
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{checker}, cltest.NeverSleeper{})
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+
+	mocketh.EventuallyAllCalled(t)
+	status := ht.SyncStatus()
+	assert.False(t, status.InSync)
+	assert.True(t, status.Syncing)
+	assert.Equal(t, int32(0), checker.ConnectedCount())
+
+	mocketh.Register("eth_syncing", false)
+	g.Eventually(func() bool { return ht.SyncStatus().InSync }).Should(gomega.BeTrue())
+	assert.Equal(t, int32(1), checker.ConnectedCount())
+
+	headers <- eth.BlockHeader{Number: cltest.BigHexInt(1)}
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(1)))
+}
+
+func TestHeadTracker_PausesWhileLatestHeadIsTooOld(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_SYNC_CHECK_INTERVAL", "1h")
+	config.Set("ETH_MAX_HEAD_AGE", "1m")
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+	mocketh := cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID)
+	headers := make(chan eth.BlockHeader)
+	mocketh.RegisterSubscription("newHeads", headers)
+	mocketh.Register("eth_syncing", false)
+
+	checker := &cltest.MockHeadTrackable{}
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{checker}, cltest.NeverSleeper{})
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+	g.Eventually(func() int32 { return checker.ConnectedCount() }).Should(gomega.Equal(int32(1)))
+
+	hourAgo := time.Now().Add(-time.Hour).Unix()
+	headers <- eth.BlockHeader{Number: cltest.BigHexInt(1), Time: hexutil.Big(*big.NewInt(hourAgo))}
+	g.Eventually(func() int32 { return checker.DisconnectedCount() }).Should(gomega.Equal(int32(1)))
+
+	status := ht.SyncStatus()
+	assert.False(t, status.InSync)
+	assert.False(t, status.Syncing)
+	assert.True(t, status.Lag > time.Hour-time.Minute)
+	assert.Equal(t, int32(0), checker.OnNewHeadCount())
+}
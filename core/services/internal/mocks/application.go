@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	eth "github.com/smartcontractkit/chainlink/core/eth"
+
 	big "math/big"
 
 	mock "github.com/stretchr/testify/mock"
@@ -11,6 +13,8 @@ import (
 
 	packr "github.com/gobuffalo/packr"
 
+	services "github.com/smartcontractkit/chainlink/core/services"
+
 	store "github.com/smartcontractkit/chainlink/core/store"
 
 	synchronization "github.com/smartcontractkit/chainlink/core/services/synchronization"
@@ -86,6 +90,20 @@ func (_m *Application) Cancel(runID *models.ID) (*models.JobRun, error) {
 	return r0, r1
 }
 
+// CancelRemovedLogRuns provides a mock function with given fields: jobSpecID, log
+func (_m *Application) CancelRemovedLogRuns(jobSpecID *models.ID, log eth.Log) error {
+	ret := _m.Called(jobSpecID, log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ID, eth.Log) error); ok {
+		r0 = rf(jobSpecID, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: jobSpecID, initiator, creationHeight, runRequest
 func (_m *Application) Create(jobSpecID *models.ID, initiator *models.Initiator, creationHeight *big.Int, runRequest *models.RunRequest) (*models.JobRun, error) {
 	ret := _m.Called(jobSpecID, initiator, creationHeight, runRequest)
@@ -178,13 +196,13 @@ func (_m *Application) NewBox() packr.Box {
 	return r0
 }
 
-// ResumeAllConfirming provides a mock function with given fields: currentBlockHeight
-func (_m *Application) ResumeAllConfirming(currentBlockHeight *big.Int) error {
-	ret := _m.Called(currentBlockHeight)
+// ResumeAllConfirming provides a mock function with given fields: chain, currentBlockHeight
+func (_m *Application) ResumeAllConfirming(chain *store.Store, currentBlockHeight *big.Int) error {
+	ret := _m.Called(chain, currentBlockHeight)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
-		r0 = rf(currentBlockHeight)
+	if rf, ok := ret.Get(0).(func(*store.Store, *big.Int) error); ok {
+		r0 = rf(chain, currentBlockHeight)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -262,6 +280,22 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// SyncStatuses provides a mock function with given fields:
+func (_m *Application) SyncStatuses() []services.SyncStatus {
+	ret := _m.Called()
+
+	var r0 []services.SyncStatus
+	if rf, ok := ret.Get(0).(func() []services.SyncStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]services.SyncStatus)
+		}
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
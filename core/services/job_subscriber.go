@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	ethsvc "github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
@@ -24,7 +25,7 @@ var (
 //go:generate mockery -name JobSubscriber  -output ../internal/mocks/ -case=underscore
 
 // JobSubscriber listens for push notifications of event logs from the ethereum
-// node's websocket for specific jobs by subscribing to ethLogs.
+// node's websocket for specific jobs by registering with the LogBroadcaster.
 type JobSubscriber interface {
 	store.HeadTrackable
 	AddJob(job models.JobSpec, bn *models.Head) error
@@ -39,28 +40,32 @@ type jobSubscriber struct {
 	jobSubscriptions          map[string]JobSubscription
 	jobsMutex                 *sync.RWMutex
 	runManager                RunManager
+	logBroadcaster            ethsvc.LogBroadcaster
 	jobResumer                SleeperTask
 	resumeRunsOnNewHeadWorker *resumeRunsOnNewHeadWorker
 }
 
 type resumeRunsOnNewHeadWorker struct {
+	store      *store.Store
 	runManager RunManager
 	head       big.Int
 }
 
 func (rw *resumeRunsOnNewHeadWorker) Work() {
-	err := rw.runManager.ResumeAllConfirming(&rw.head)
+	err := rw.runManager.ResumeAllConfirming(rw.store, &rw.head)
 	if err != nil {
 		logger.Errorw("Failed to resume confirming tasks on new head", "error", err)
 	}
 }
 
-// NewJobSubscriber returns a new job subscriber.
-func NewJobSubscriber(store *store.Store, runManager RunManager) JobSubscriber {
-	rw := &resumeRunsOnNewHeadWorker{runManager: runManager}
+// NewJobSubscriber returns a new job subscriber, whose jobs share the logs of
+// the logBroadcaster.
+func NewJobSubscriber(store *store.Store, runManager RunManager, logBroadcaster ethsvc.LogBroadcaster) JobSubscriber {
+	rw := &resumeRunsOnNewHeadWorker{store: store, runManager: runManager}
 	js := &jobSubscriber{
 		store:                     store,
 		runManager:                runManager,
+		logBroadcaster:            logBroadcaster,
 		jobSubscriptions:          map[string]JobSubscription{},
 		jobsMutex:                 &sync.RWMutex{},
 		jobResumer:                NewSleeperTask(rw),
@@ -74,13 +79,14 @@ func (js *jobSubscriber) Stop() error {
 }
 
 // AddJob subscribes to ethereum log events for each "runlog" and "ethlog"
-// initiator in the passed job spec.
+// initiator in the passed job spec. With Ethereum disabled there are no logs,
+// and the log broadcaster is not started, so nothing is subscribed to.
 func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.Head) error {
-	if !job.IsLogInitiated() {
+	if !job.IsLogInitiated() || js.store.Config.EthereumDisabled() {
 		return nil
 	}
 
-	sub, err := StartJobSubscription(job, bn, js.store, js.runManager)
+	sub, err := StartJobSubscription(job, bn, js.store, js.runManager, js.logBroadcaster)
 	if err != nil {
 		return err
 	}
@@ -128,7 +134,9 @@ func (js *jobSubscriber) Connect(bn *models.Head) error {
 	var merr error
 	err := js.store.Jobs(
 		func(j *models.JobSpec) bool {
-			merr = multierr.Append(merr, js.AddJob(*j, bn))
+			if js.store.OnChain(j.ChainID) {
+				merr = multierr.Append(merr, js.AddJob(*j, bn))
+			}
 			return true
 		},
 		models.InitiatorEthLog,
@@ -151,6 +159,13 @@ func (js *jobSubscriber) Disconnect() {
 	js.jobSubscriptions = map[string]JobSubscription{}
 }
 
+// OnReorg resubscribes every job from the common ancestor, so logs from the
+// blocks that replaced the orphaned ones are picked up.
+func (js *jobSubscriber) OnReorg(ancestor *models.Head, head *models.Head) {
+	js.Disconnect()
+	logger.WarnIf(js.Connect(ancestor))
+}
+
 // OnNewHead resumes all pending job runs based on the new head activity.
 func (js *jobSubscriber) OnNewHead(head *models.Head) {
 	js.resumeRunsOnNewHeadWorker.head = *head.ToInt()
@@ -5,7 +5,6 @@ import (
 	"sync"
 	"testing"
 
-	ethpkg "github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services"
@@ -23,21 +22,21 @@ func TestJobSubscriber_OnNewHead(t *testing.T) {
 	defer cleanup()
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, new(mocks.LogBroadcaster))
 	defer jobSubscriber.Stop()
 
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	resumeJobChannel := make(chan struct{})
 
-	runManager.On("ResumeAllConfirming", big.NewInt(1337)).
+	runManager.On("ResumeAllConfirming", store, big.NewInt(1337)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
 			wg.Done()
 			resumeJobChannel <- struct{}{}
 		})
-	runManager.On("ResumeAllConfirming", big.NewInt(1339)).
+	runManager.On("ResumeAllConfirming", store, big.NewInt(1339)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
@@ -61,7 +60,7 @@ func TestJobSubscriber_OnNewHead(t *testing.T) {
 	})
 
 	// Make sure after dropping a head (because of congestion) that it resumes again
-	runManager.On("ResumeAllConfirming", big.NewInt(1340)).
+	runManager.On("ResumeAllConfirming", store, big.NewInt(1340)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
@@ -81,24 +80,28 @@ func TestJobSubscriber_AddJob_RemoveJob(t *testing.T) {
 
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
-	cltest.MockEthOnStore(t, store, cltest.LenientEthMock)
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	logBroadcaster := new(mocks.LogBroadcaster)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, logBroadcaster)
 	defer jobSubscriber.Stop()
 
 	jobSpec := cltest.NewJobWithLogInitiator()
+	address := jobSpec.Initiators[0].Address
+	logBroadcaster.On("Register", address, mock.Anything).Return(true).Once()
 	err := jobSubscriber.AddJob(jobSpec, cltest.Head(321))
 	require.NoError(t, err)
 
 	assert.Len(t, jobSubscriber.Jobs(), 1)
 
+	logBroadcaster.On("Unregister", address, mock.Anything).Return().Once()
 	err = jobSubscriber.RemoveJob(jobSpec.ID)
 	require.NoError(t, err)
 
 	assert.Len(t, jobSubscriber.Jobs(), 0)
 
 	runManager.AssertExpectations(t)
+	logBroadcaster.AssertExpectations(t)
 }
 
 func TestJobSubscriber_AddJob_NotLogInitiatedError(t *testing.T) {
@@ -108,7 +111,7 @@ func TestJobSubscriber_AddJob_NotLogInitiatedError(t *testing.T) {
 	defer cleanup()
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, new(mocks.LogBroadcaster))
 	defer jobSubscriber.Stop()
 
 	job := models.JobSpec{}
@@ -123,7 +126,7 @@ func TestJobSubscriber_RemoveJob_NotFoundError(t *testing.T) {
 	defer cleanup()
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, new(mocks.LogBroadcaster))
 	defer jobSubscriber.Stop()
 
 	err := jobSubscriber.RemoveJob(models.NewID())
@@ -137,28 +140,24 @@ func TestJobSubscriber_Connect_Disconnect(t *testing.T) {
 	defer cleanup()
 
 	runManager := new(mocks.RunManager)
-	jobSubscriber := services.NewJobSubscriber(store, runManager)
-
-	eth := cltest.MockEthOnStore(t, store)
-	eth.Register("eth_getLogs", []ethpkg.Log{})
-	eth.Register("eth_getLogs", []ethpkg.Log{})
+	logBroadcaster := new(mocks.LogBroadcaster)
+	jobSubscriber := services.NewJobSubscriber(store, runManager, logBroadcaster)
 
 	jobSpec1 := cltest.NewJobWithLogInitiator()
 	jobSpec2 := cltest.NewJobWithLogInitiator()
 	require.Nil(t, store.CreateJob(&jobSpec1))
 	require.Nil(t, store.CreateJob(&jobSpec2))
-	eth.RegisterSubscription("logs")
-	eth.RegisterSubscription("logs")
+	logBroadcaster.On("Register", mock.Anything, mock.Anything).Return(true).Twice()
 
 	require.Nil(t, jobSubscriber.Connect(cltest.Head(491)))
 
 	jobSubscriber.Stop()
 
-	eth.EventuallyAllCalled(t)
-
 	assert.Len(t, jobSubscriber.Jobs(), 2)
 
+	logBroadcaster.On("Unregister", mock.Anything, mock.Anything).Return().Twice()
 	jobSubscriber.Disconnect()
 
 	assert.Len(t, jobSubscriber.Jobs(), 0)
+	logBroadcaster.AssertExpectations(t)
 }
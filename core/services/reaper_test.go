@@ -60,3 +60,35 @@ func TestStoreReaper_ReapSessions(t *testing.T) {
 		})
 	}
 }
+
+func TestRunReaper_PrunesRunsExceedingCountPerJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set("RUN_RETENTION_MAX_RUNS_PER_JOB", 1)
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	for i := 0; i < 3; i++ {
+		run := cltest.NewJobRun(job)
+		run.SetStatus(models.RunStatusCompleted)
+		require.NoError(t, store.CreateJobRun(&run))
+	}
+	inProgress := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&inProgress))
+
+	r := services.NewRunReaper(store)
+	defer r.Stop()
+	r.WakeUp()
+
+	gomega.NewGomegaWithT(t).Eventually(func() int {
+		count, err := store.JobRunsCountFor(job.ID)
+		require.NoError(t, err)
+		return count
+	}).Should(gomega.Equal(2))
+
+	_, err := store.FindJobRun(inProgress.ID)
+	assert.NoError(t, err)
+}
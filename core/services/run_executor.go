@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/adapters"
@@ -52,6 +53,11 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 		return errors.Wrapf(err, "error finding run %s", runID)
 	}
 
+	var finalized *big.Int
+	if run.CreationHeight != nil {
+		finalized = re.finalizedHeight(&run)
+	}
+
 	for taskIndex := range run.TaskRuns {
 		taskRun := &run.TaskRuns[taskIndex]
 		if !run.GetStatus().Runnable() {
@@ -63,7 +69,7 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 			continue
 		}
 
-		if meetsMinimumConfirmations(&run, taskRun, run.ObservedHeight) {
+		if meetsMinimumConfirmations(&run, taskRun, run.ObservedHeight, finalized) {
 			start := time.Now()
 
 			result := re.executeTask(&run, taskRun)
@@ -71,9 +77,12 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 			taskRun.ApplyOutput(result)
 			run.ApplyOutput(result)
 
-			elapsed := time.Since(start).Seconds()
+			elapsed := time.Since(start)
+			// A task may be executed several times, e.g. while pending
+			// confirmations, so the time spent accumulates
+			taskRun.Elapsed = models.MustMakeDuration(taskRun.Elapsed.Duration() + elapsed)
 
-			logger.Debugw(fmt.Sprintf("Executed task %s", taskRun.TaskSpec.Type), run.ForLogger("task", taskRun.ID.String(), "elapsed", elapsed)...)
+			logger.Debugw(fmt.Sprintf("Executed task %s", taskRun.TaskSpec.Type), run.ForLogger("task", taskRun.ID.String(), "elapsed", elapsed.Seconds())...)
 
 		} else {
 			logger.Debugw("Pausing run pending confirmations",
@@ -104,6 +113,22 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 	return nil
 }
 
+// finalizedHeight returns the number of the block at the finality tag of the
+// run's job, or nil if the run counts confirmations.
+func (re *runExecutor) finalizedHeight(run *models.JobRun) *big.Int {
+	chain, err := re.store.ForJob(run.JobSpecID)
+	if err != nil {
+		logger.Errorw("Unable to find the chain of run", run.ForLogger("error", err)...)
+		return nil
+	}
+	tag, err := finalityTag(chain, run.JobSpecID)
+	if err != nil {
+		logger.Errorw("Unable to find the finality tag of run", run.ForLogger("error", err)...)
+		return nil
+	}
+	return finalizedHeight(chain, tag)
+}
+
 func (re *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun) models.RunOutput {
 	taskCopy := taskRun.TaskSpec // deliberately copied to keep mutations local
 
@@ -112,6 +137,7 @@ func (re *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun)
 		return models.NewRunOutputError(err)
 	}
 	taskCopy.Params = params
+	taskRun.Params = params
 
 	adapter, err := adapters.For(taskCopy, re.store.Config, re.store.ORM)
 	if err != nil {
@@ -130,8 +156,15 @@ func (re *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun)
 		return models.NewRunOutputError(err)
 	}
 
+	taskRun.Input = data
+
+	chain, err := re.store.ForJob(run.JobSpecID)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
 	input := *models.NewRunInput(run.ID, data, taskRun.Status)
-	result := adapter.Perform(input, re.store)
+	result := adapter.Perform(input, chain)
 	promAdapterCallsVec.WithLabelValues(run.JobSpecID.String(), string(adapter.TaskType()), string(result.Status())).Inc()
 
 	return result
@@ -57,6 +57,42 @@ func TestRunExecutor_Execute(t *testing.T) {
 	assert.Equal(t, assets.NewLink(9117), actual)
 }
 
+func TestRunExecutor_Execute_RecordsTaskTrace(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher)
+
+	j := models.NewJob()
+	i := models.Initiator{Type: models.InitiatorWeb}
+	j.Initiators = []models.Initiator{i}
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "multiply", `{"times": 2}`),
+	}
+	assert.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	run.RunRequest.RequestParams = cltest.JSONFromString(t, `{"result": "21"}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	require.Len(t, run.TaskRuns, 1)
+	tr := run.TaskRuns[0]
+	assert.Equal(t, models.RunStatusCompleted, tr.Status)
+	assert.Equal(t, "2", tr.Params.Get("times").String())
+	assert.Equal(t, "21", tr.Input.Get("result").String())
+	assert.Equal(t, "42", tr.Result.Data.Get("result").String())
+	assert.False(t, tr.Elapsed.IsInstant())
+}
+
 func TestRunExecutor_Execute_Pending(t *testing.T) {
 	t.Parallel()
 
@@ -147,7 +183,7 @@ func TestRunExecutor_Execute_CancelActivelyRunningTask(t *testing.T) {
 	time.Sleep(1 * time.Second)
 
 	runQueue := new(mocks.RunQueue)
-	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 	_, err := runManager.Cancel(run.ID)
 	require.NoError(t, err)
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
@@ -15,7 +16,26 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+)
+
+var (
+	promRunRequestsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "run_manager_run_requests_rejected_total",
+		Help: "Number of run requests rejected or collapsed into an existing run, by job and reason",
+	},
+		[]string{"job_spec_id", "reason"},
+	)
+	promReorgAffectedRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "run_manager_reorg_affected_runs_total",
+		Help: "Number of runs created from logs later removed by a chain reorganization, by job and whether they were cancelled or had already finished",
+	},
+		[]string{"job_spec_id", "outcome"},
+	)
 )
 
 // RecurringScheduleJobError contains the field for the error message.
@@ -28,6 +48,18 @@ func (err RecurringScheduleJobError) Error() string {
 	return err.msg
 }
 
+// RunRejectedError is returned when a job has reached one of its run limits
+// and a new run for it was refused.
+type RunRejectedError struct {
+	reason string
+	msg    string
+}
+
+// Error returns the reason the run was rejected.
+func (err RunRejectedError) Error() string {
+	return err.msg
+}
+
 //go:generate mockery -name RunManager -output ../internal/mocks/ -case=underscore
 
 // RunManager supplies methods for queueing, resuming and cancelling jobs in
@@ -46,9 +78,10 @@ type RunManager interface {
 		runID *models.ID,
 		input models.BridgeRunResult) error
 	Cancel(runID *models.ID) (*models.JobRun, error)
+	CancelRemovedLogRuns(jobSpecID *models.ID, log eth.Log) error
 
 	ResumeAllInProgress() error
-	ResumeAllConfirming(currentBlockHeight *big.Int) error
+	ResumeAllConfirming(chain *store.Store, currentBlockHeight *big.Int) error
 	ResumeAllConnecting() error
 }
 
@@ -57,7 +90,6 @@ type runManager struct {
 	orm         *orm.ORM
 	statsPusher synchronization.StatsPusher
 	runQueue    RunQueue
-	txManager   store.TxManager
 	config      orm.ConfigReader
 	clock       utils.AfterNower
 }
@@ -142,13 +174,11 @@ func NewRunManager(
 	config orm.ConfigReader,
 	orm *orm.ORM,
 	statsPusher synchronization.StatsPusher,
-	txManager store.TxManager,
 	clock utils.AfterNower) RunManager {
 	return &runManager{
 		orm:         orm,
 		statsPusher: statsPusher,
 		runQueue:    runQueue,
-		txManager:   txManager,
 		config:      config,
 		clock:       clock,
 	}
@@ -221,6 +251,32 @@ func (rm *runManager) Create(
 		return nil, fmt.Errorf("invariant for job %s: no tasks to run in NewRun", job.ID)
 	}
 
+	if runRequest != nil && runRequest.IdempotencyKey.Valid {
+		duplicate, err := rm.orm.FindJobRunByIdempotencyKey(
+			job.ID, runRequest.IdempotencyKey.String, rm.config.RunRequestDedupWindow().Before(now))
+		if err == nil {
+			logger.Debugw("Collapsing duplicate run request into existing run",
+				duplicate.ForLogger("idempotency_key", runRequest.IdempotencyKey.String)...)
+			promRunRequestsRejected.WithLabelValues(job.ID.String(), "duplicate").Inc()
+			return &duplicate, nil
+		} else if !gorm.IsRecordNotFoundError(err) {
+			return nil, errors.Wrap(err, "failed to look up run by idempotency key")
+		}
+	}
+
+	if err := rm.checkRunLimits(&job, now); err != nil {
+		rejection, ok := err.(RunRejectedError)
+		if !ok {
+			return nil, err
+		}
+		logger.Warnw("Rejecting run request", "job", job.ID.String(), "reason", rejection.reason)
+		promRunRequestsRejected.WithLabelValues(job.ID.String(), rejection.reason).Inc()
+		if _, cerr := rm.CreateErrored(job.ID, *initiator, rejection); cerr != nil {
+			logger.Errorw("Unable to record rejected run request", "job", job.ID.String(), "error", cerr)
+		}
+		return nil, rejection
+	}
+
 	run, adapters := NewRun(&job, initiator, creationHeight, runRequest, rm.config, rm.orm, now)
 	runCost := runCost(&job, rm.config, adapters)
 	ValidateRun(run, runCost)
@@ -240,10 +296,60 @@ func (rm *runManager) Create(
 	return run, nil
 }
 
-// ResumeAllConfirming wakes up all jobs that were sleeping because they were
-// waiting for block confirmations.
-func (rm *runManager) ResumeAllConfirming(currentBlockHeight *big.Int) error {
-	return rm.orm.UnscopedJobRunsWithStatus(func(run *models.JobRun) {
+// checkRunLimits returns a RunRejectedError if starting another run would take
+// the job over its run rate limit or maximum in flight runs. The limits of the
+// job spec take precedence over JobRunRateLimit, JobRunRateLimitInterval and
+// JobMaxInFlightRuns. They are checked against the database rather than
+// enforced atomically, so concurrent requests may overshoot them slightly.
+func (rm *runManager) checkRunLimits(job *models.JobSpec, now time.Time) error {
+	rateLimit := rm.config.JobRunRateLimit()
+	if job.RunRateLimit.Valid {
+		rateLimit = job.RunRateLimit.Uint32
+	}
+	interval := rm.config.JobRunRateLimitInterval()
+	if !job.RunRateLimitInterval.IsInstant() {
+		interval = job.RunRateLimitInterval
+	}
+	maxInFlight := rm.config.JobMaxInFlightRuns()
+	if job.MaxInFlightRuns.Valid {
+		maxInFlight = job.MaxInFlightRuns.Uint32
+	}
+
+	if limit := rateLimit; limit > 0 {
+		count, err := rm.orm.JobRunsCreatedSinceCountFor(job.ID, interval.Before(now))
+		if err != nil {
+			return errors.Wrap(err, "failed to count recent runs")
+		}
+		if count >= int(limit) {
+			return RunRejectedError{
+				reason: "rate_limit",
+				msg:    fmt.Sprintf("Job %s has reached its limit of %d runs per %s", job.ID, limit, interval),
+			}
+		}
+	}
+
+	if limit := maxInFlight; limit > 0 {
+		count, err := rm.orm.UnfinishedJobRunsCountFor(job.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to count unfinished runs")
+		}
+		if count >= int(limit) {
+			return RunRejectedError{
+				reason: "max_in_flight",
+				msg:    fmt.Sprintf("Job %s has reached its limit of %d unfinished runs", job.ID, limit),
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResumeAllConfirming wakes up all jobs on the chain that were sleeping
+// because they were waiting for block confirmations, or for their block to be
+// final.
+func (rm *runManager) ResumeAllConfirming(chain *store.Store, currentBlockHeight *big.Int) error {
+	tags := map[string]models.FinalityTag{}
+	return rm.orm.UnscopedJobRunsOnChainWithStatus(func(run *models.JobRun) {
 		currentTaskRun := run.NextTaskRun()
 		if currentTaskRun == nil {
 			rm.updateWithError(run, "Attempting to resume confirming run with no remaining tasks %s", run.ID)
@@ -253,13 +359,23 @@ func (rm *runManager) ResumeAllConfirming(currentBlockHeight *big.Int) error {
 		run.ObservedHeight = utils.NewBig(currentBlockHeight)
 		logger.Debugw(fmt.Sprintf("New head #%s resuming run", currentBlockHeight), run.ForLogger()...)
 
-		validateMinimumConfirmations(run, currentTaskRun, run.ObservedHeight, rm.txManager)
+		tag, ok := tags[run.JobSpecID.String()]
+		if !ok {
+			var err error
+			if tag, err = finalityTag(chain, run.JobSpecID); err != nil {
+				logger.Errorw("Unable to find the finality tag of run", run.ForLogger("error", err)...)
+			}
+			tags[run.JobSpecID.String()] = tag
+		}
+		finalized := finalizedHeight(chain, tag)
+
+		validateMinimumConfirmations(run, currentTaskRun, run.ObservedHeight, finalized, chain.TxManager)
 
 		err := rm.updateAndTrigger(run)
 		if err != nil {
 			logger.Errorw("Error saving run", run.ForLogger("error", err)...)
 		}
-	}, models.RunStatusPendingConnection, models.RunStatusPendingConfirmations)
+	}, chain.ChainID, models.RunStatusPendingConnection, models.RunStatusPendingConfirmations)
 }
 
 // ResumeAllConnecting wakes up all tasks that have gone to sleep because they
@@ -345,6 +461,36 @@ func (rm *runManager) Cancel(runID *models.ID) (*models.JobRun, error) {
 	return &run, rm.orm.SaveJobRun(&run)
 }
 
+// CancelRemovedLogRuns cancels the unfinished runs of the job created from a
+// log that a chain reorganization removed. Runs that already finished are
+// flagged, since their results may have been written to the chain.
+func (rm *runManager) CancelRemovedLogRuns(jobSpecID *models.ID, log eth.Log) error {
+	runs, err := rm.orm.JobRunsForLog(jobSpecID, log.TxHash, log.BlockHash)
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+	defer rm.statsPusher.PushNow()
+
+	var merr error
+	for i := range runs {
+		run := &runs[i]
+		if run.GetStatus().Finished() {
+			logger.Warnw("Run finished for a log since removed by a chain reorganization", run.ForLogger()...)
+			promReorgAffectedRuns.WithLabelValues(jobSpecID.String(), "finished").Inc()
+			continue
+		}
+
+		logger.Infow("Cancelling run for a log removed by a chain reorganization", run.ForLogger()...)
+		run.Cancel()
+		merr = multierr.Append(merr, rm.orm.SaveJobRun(run))
+		promReorgAffectedRuns.WithLabelValues(jobSpecID.String(), "cancelled").Inc()
+	}
+	return merr
+}
+
 func (rm *runManager) updateWithError(run *models.JobRun, msg string, args ...interface{}) error {
 	run.SetError(fmt.Errorf(msg, args...))
 	logger.Error(fmt.Sprintf(msg, args...))
@@ -20,6 +20,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -49,7 +50,7 @@ func TestRunManager_ResumePending(t *testing.T) {
 	runQueue := new(mocks.RunQueue)
 	runQueue.On("Run", mock.Anything).Maybe().Return(nil)
 
-	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 
 	input := cltest.JSONFromString(t, `{"address":"0xdfcfc2b9200dbb10952c2b7cce60fc7260e03c6f"}`)
 
@@ -135,14 +136,14 @@ func TestRunManager_ResumeAllConfirming(t *testing.T) {
 	runQueue := new(mocks.RunQueue)
 	runQueue.On("Run", mock.Anything).Maybe().Return(nil)
 
-	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 
 	t.Run("reject a run with no tasks", func(t *testing.T) {
 		run := makeJobRunWithInitiator(t, store, models.NewJob())
 		run.SetStatus(models.RunStatusPendingConfirmations)
 		require.NoError(t, store.CreateJobRun(&run))
 
-		err := runManager.ResumeAllConfirming(nil)
+		err := runManager.ResumeAllConfirming(store, nil)
 		assert.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -156,7 +157,7 @@ func TestRunManager_ResumeAllConfirming(t *testing.T) {
 		run.TaskRuns[0].MinimumConfirmations = clnull.Uint32From(2)
 		require.NoError(t, store.CreateJobRun(&run))
 
-		err := runManager.ResumeAllConfirming(big.NewInt(0))
+		err := runManager.ResumeAllConfirming(store, big.NewInt(0))
 		require.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -172,7 +173,7 @@ func TestRunManager_ResumeAllConfirming(t *testing.T) {
 		require.NoError(t, store.CreateJobRun(&run))
 
 		observedHeight := big.NewInt(1)
-		err := runManager.ResumeAllConfirming(observedHeight)
+		err := runManager.ResumeAllConfirming(store, observedHeight)
 		require.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -180,9 +181,92 @@ func TestRunManager_ResumeAllConfirming(t *testing.T) {
 		assert.Equal(t, string(models.RunStatusInProgress), string(run.GetStatus()))
 	})
 
+	t.Run("wait for the block at the job's finality tag instead of counting confirmations", func(t *testing.T) {
+		job := cltest.NewJob()
+		job.FinalityTag = models.FinalityTagFinalized
+		run := makeJobRunWithInitiator(t, store, job)
+		run.SetStatus(models.RunStatusPendingConfirmations)
+		run.CreationHeight = utils.NewBig(big.NewInt(10))
+		run.TaskRuns[0].MinimumConfirmations = clnull.Uint32From(2)
+		require.NoError(t, store.CreateJobRun(&run))
+
+		txManager := new(mocks.TxManager)
+		txManager.On("FinalizedHeight", models.FinalityTagFinalized).Return(big.NewInt(9), nil).Once()
+		txManager.On("FinalizedHeight", models.FinalityTagFinalized).Return(big.NewInt(10), nil).Once()
+		store.TxManager = txManager
+
+		require.NoError(t, runManager.ResumeAllConfirming(store, big.NewInt(20)))
+		run, err := store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusPendingConfirmations, run.GetStatus())
+
+		require.NoError(t, runManager.ResumeAllConfirming(store, big.NewInt(21)))
+		run, err = store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusInProgress, run.GetStatus())
+		assert.Equal(t, uint32(2), run.TaskRuns[0].Confirmations.Uint32)
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("wait for the next head if the block at the job's finality tag is unknown", func(t *testing.T) {
+		job := cltest.NewJob()
+		job.FinalityTag = models.FinalityTagFinalized
+		run := makeJobRunWithInitiator(t, store, job)
+		run.SetStatus(models.RunStatusPendingConfirmations)
+		run.CreationHeight = utils.NewBig(big.NewInt(10))
+		run.TaskRuns[0].MinimumConfirmations = clnull.Uint32From(2)
+		require.NoError(t, store.CreateJobRun(&run))
+
+		txManager := new(mocks.TxManager)
+		txManager.On("FinalizedHeight", models.FinalityTagFinalized).Return(nil, errors.New("connection refused")).Once()
+		store.TxManager = txManager
+
+		require.NoError(t, runManager.ResumeAllConfirming(store, big.NewInt(20)))
+		run, err := store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusPendingConfirmations, run.GetStatus())
+		txManager.AssertExpectations(t)
+	})
+
 	runQueue.AssertExpectations(t)
 }
 
+func TestRunManager_CancelRemovedLogRuns(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+	runManager := services.NewRunManager(new(mocks.RunQueue), store.Config, store.ORM, pusher, store.Clock)
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	log := eth.Log{TxHash: cltest.NewHash(), BlockHash: cltest.NewHash()}
+
+	newRun := func(blockHash common.Hash, status models.RunStatus) models.JobRun {
+		txHash := log.TxHash
+		run := models.MakeJobRun(&job, time.Now(), &job.Initiators[0], big.NewInt(0), &models.RunRequest{TxHash: &txHash, BlockHash: &blockHash})
+		run.SetStatus(status)
+		require.NoError(t, store.CreateJobRun(&run))
+		return run
+	}
+	inProgress := newRun(log.BlockHash, models.RunStatusPendingConfirmations)
+	completed := newRun(log.BlockHash, models.RunStatusCompleted)
+	otherBlock := newRun(cltest.NewHash(), models.RunStatusPendingConfirmations)
+
+	require.NoError(t, runManager.CancelRemovedLogRuns(job.ID, log))
+
+	for run, want := range map[*models.ID]models.RunStatus{
+		inProgress.ID: models.RunStatusCancelled,
+		completed.ID:  models.RunStatusCompleted,
+		otherBlock.ID: models.RunStatusPendingConfirmations,
+	} {
+		found, err := store.FindJobRun(run)
+		require.NoError(t, err)
+		assert.Equal(t, want, found.GetStatus())
+	}
+}
+
 func TestRunManager_ResumeAllConnecting(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -193,7 +277,7 @@ func TestRunManager_ResumeAllConnecting(t *testing.T) {
 	runQueue := new(mocks.RunQueue)
 	runQueue.On("Run", mock.Anything).Maybe().Return(nil)
 
-	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 
 	t.Run("reject a run with no tasks", func(t *testing.T) {
 		run := makeJobRunWithInitiator(t, store, models.NewJob())
@@ -303,6 +387,102 @@ func TestRunManager_Create_DoesNotSaveToTaskSpec(t *testing.T) {
 	assert.JSONEq(t, job.Tasks[0].Params.String(), retrievedJob.Tasks[0].Params.String())
 }
 
+func TestRunManager_Create_CollapsesDuplicateIdempotencyKey(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.EthMockRegisterChainID)
+	defer cleanup()
+
+	store := app.Store
+
+	app.StartAndConnect()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "NoOp")}
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	rr := &models.RunRequest{IdempotencyKey: null.StringFrom("request-1")}
+	first, err := app.RunManager.Create(job.ID, &initiator, nil, rr)
+	require.NoError(t, err)
+	cltest.WaitForJobRunToComplete(t, store, *first)
+
+	rr = &models.RunRequest{IdempotencyKey: null.StringFrom("request-1")}
+	second, err := app.RunManager.Create(job.ID, &initiator, nil, rr)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+
+	rr = &models.RunRequest{IdempotencyKey: null.StringFrom("request-2")}
+	third, err := app.RunManager.Create(job.ID, &initiator, nil, rr)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, third.ID)
+}
+
+func TestRunManager_Create_RejectsRunsOverRateLimit(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.EthMockRegisterChainID)
+	defer cleanup()
+
+	store := app.Store
+	store.Config.Set("JOB_RUN_RATE_LIMIT", 1)
+	store.Config.Set("JOB_RUN_RATE_LIMIT_INTERVAL", "1h")
+
+	app.StartAndConnect()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "NoOp")}
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	jr, err := app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.NoError(t, err)
+	cltest.WaitForJobRunToComplete(t, store, *jr)
+
+	_, err = app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.Error(t, err)
+	assert.IsType(t, services.RunRejectedError{}, err)
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, models.RunStatusErrored, runs[0].GetStatus())
+	assert.Contains(t, runs[0].Result.ErrorMessage.String, "limit of 1 runs")
+}
+
+func TestRunManager_Create_JobRunLimitsOverrideConfig(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.EthMockRegisterChainID)
+	defer cleanup()
+
+	store := app.Store
+	store.Config.Set("JOB_RUN_RATE_LIMIT", 1)
+	store.Config.Set("JOB_RUN_RATE_LIMIT_INTERVAL", "1h")
+	store.Config.Set("JOB_MAX_IN_FLIGHT_RUNS", 1)
+
+	app.StartAndConnect()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "NoOp")}
+	job.RunRateLimit = clnull.Uint32From(2)
+	job.MaxInFlightRuns = clnull.Uint32From(0)
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	for i := 0; i < 2; i++ {
+		jr, err := app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+		require.NoError(t, err)
+		cltest.WaitForJobRunToComplete(t, store, *jr)
+	}
+
+	_, err := app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.Error(t, err)
+	assert.IsType(t, services.RunRejectedError{}, err)
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+	assert.Contains(t, runs[0].Result.ErrorMessage.String, "limit of 2 runs per 1h0m0s")
+}
+
 func TestRunManager_Create_fromRunLog_Happy(t *testing.T) {
 	t.Parallel()
 
@@ -373,7 +553,7 @@ func TestRunManager_Create_fromRunLog_Happy(t *testing.T) {
 				meth.Register("eth_getTransactionReceipt", confirmedReceipt)
 			})
 
-			err = app.RunManager.ResumeAllConfirming(big.NewInt(2))
+			err = app.RunManager.ResumeAllConfirming(app.Store, big.NewInt(2))
 			require.NoError(t, err)
 			run = cltest.WaitForJobRunStatus(t, app.Store, *jr, test.wantStatus)
 			assert.Equal(t, rr.RequestID, run.RunRequest.RequestID)
@@ -613,7 +793,7 @@ func TestRunManager_Create_fromRunLogPayments(t *testing.T) {
 			runQueue := new(mocks.RunQueue)
 			runQueue.On("Run", mock.Anything).Return(nil)
 
-			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 			run, err := runManager.Create(job.ID, &initiator, creationHeight, runRequest)
 			require.NoError(t, err)
 
@@ -663,7 +843,7 @@ func TestRunManager_Create_fromRunLog_ConnectToLaggingEthNode(t *testing.T) {
 	require.NoError(t, err)
 	cltest.WaitForJobRunToPendConfirmations(t, app.Store, *jr)
 
-	err = app.RunManager.ResumeAllConfirming(pastCurrentHeight)
+	err = app.RunManager.ResumeAllConfirming(app.Store, pastCurrentHeight)
 	require.NoError(t, err)
 	updatedJR := cltest.WaitForJobRunToPendConfirmations(t, app.Store, *jr)
 	assert.True(t, updatedJR.TaskRuns[0].Confirmations.Valid)
@@ -699,8 +879,8 @@ func TestRunManager_ResumeConfirmingTasks(t *testing.T) {
 			runQueue := new(mocks.RunQueue)
 			runQueue.On("Run", mock.Anything).Return(nil)
 
-			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
-			runManager.ResumeAllConfirming(big.NewInt(3821))
+			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
+			runManager.ResumeAllConfirming(store, big.NewInt(3821))
 
 			runQueue.AssertExpectations(t)
 		})
@@ -733,7 +913,7 @@ func TestRunManager_ResumeAllInProgress(t *testing.T) {
 			runQueue := new(mocks.RunQueue)
 			runQueue.On("Run", mock.Anything).Return(nil)
 
-			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 			runManager.ResumeAllInProgress()
 
 			runQueue.AssertExpectations(t)
@@ -769,7 +949,7 @@ func TestRunManager_ResumeAllInProgress_Archived(t *testing.T) {
 			runQueue := new(mocks.RunQueue)
 			runQueue.On("Run", mock.Anything).Return(nil)
 
-			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 			runManager.ResumeAllInProgress()
 
 			runQueue.AssertExpectations(t)
@@ -806,7 +986,7 @@ func TestRunManager_ResumeAllInProgress_NotInProgress(t *testing.T) {
 			runQueue := new(mocks.RunQueue)
 			runQueue.On("Run", mock.Anything).Maybe().Return(nil)
 
-			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 			runManager.ResumeAllInProgress()
 
 			runQueue.AssertExpectations(t)
@@ -844,7 +1024,7 @@ func TestRunManager_ResumeAllInProgress_NotInProgressAndArchived(t *testing.T) {
 			runQueue := new(mocks.RunQueue)
 			runQueue.On("Run", mock.Anything).Maybe().Return(nil)
 
-			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.Clock)
 			runManager.ResumeAllInProgress()
 
 			runQueue.AssertExpectations(t)
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promRunReaperRowsRemoved = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "run_reaper_rows_removed_total",
+		Help: "Number of rows removed by the run reaper, by table",
+	},
+		[]string{"table"},
+	)
+)
+
+// RunReaper periodically prunes finished job runs, and the history attached
+// to them, according to the configured run retention policy.
+type RunReaper interface {
+	SleeperTask
+	Start() error
+}
+
+type runReaper struct {
+	SleeperTask
+	store  *store.Store
+	config orm.ConfigReader
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewRunReaper creates a reaper that applies the run retention policy every
+// RunReaperInterval. Pruning is disabled when neither RunRetentionMaxAge nor
+// RunRetentionMaxRunsPerJob is set.
+func NewRunReaper(store *store.Store) RunReaper {
+	rr := &runReaper{
+		store:  store,
+		config: store.Config,
+		chStop: make(chan struct{}),
+	}
+	rr.SleeperTask = NewSleeperTask(&runReaperWorker{rr})
+	return rr
+}
+
+// Start begins waking the reaper every RunReaperInterval.
+func (rr *runReaper) Start() error {
+	interval := rr.config.RunReaperInterval()
+	if interval.IsInstant() {
+		logger.Info("RunReaper: RUN_REAPER_INTERVAL is 0, automatic pruning disabled")
+		return nil
+	}
+
+	rr.wg.Add(1)
+	go func() {
+		defer rr.wg.Done()
+		ticker := time.NewTicker(interval.Duration())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				rr.WakeUp()
+			case <-rr.chStop:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop halts the ticker and waits for any pruning in progress to finish.
+func (rr *runReaper) Stop() error {
+	close(rr.chStop)
+	rr.wg.Wait()
+	return rr.SleeperTask.Stop()
+}
+
+type runReaperWorker struct {
+	*runReaper
+}
+
+func (w *runReaperWorker) Work() {
+	statuses := w.finishedStatuses()
+	if len(statuses) == 0 {
+		return
+	}
+	batchSize := w.config.RunRetentionBatchSize()
+	if batchSize == 0 {
+		logger.Warn("RunReaper: RUN_RETENTION_BATCH_SIZE is 0, skipping pruning")
+		return
+	}
+
+	if maxAge := w.config.RunRetentionMaxAge(); !maxAge.IsInstant() {
+		threshold := maxAge.Before(time.Now())
+		w.pruneRuns("age", batchSize, func() (orm.PrunedRuns, error) {
+			return w.store.PruneJobRunsUpdatedBefore(statuses, threshold, batchSize)
+		})
+		w.pruneTable("sync_events", batchSize, func() (int64, error) {
+			return w.store.PruneSyncEventsBefore(threshold, batchSize)
+		})
+		w.pruneTable("log_consumptions", batchSize, func() (int64, error) {
+			return w.store.PruneLogConsumptionsBefore(threshold, batchSize)
+		})
+	}
+
+	if keep := w.config.RunRetentionMaxRunsPerJob(); keep > 0 {
+		w.pruneRuns("count", batchSize, func() (orm.PrunedRuns, error) {
+			return w.store.PruneJobRunsExceedingCount(statuses, keep, batchSize)
+		})
+	}
+}
+
+// finishedStatuses filters the configured statuses down to those of finished
+// runs, so that a misconfiguration can never prune runs still in progress.
+func (w *runReaperWorker) finishedStatuses() models.RunStatusCollection {
+	var statuses models.RunStatusCollection
+	for _, status := range w.config.RunRetentionStatuses() {
+		if !status.Finished() {
+			logger.Warnf("RunReaper: ignoring status %s in RUN_RETENTION_STATUSES, only finished runs can be pruned", status)
+			continue
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// pruneRuns calls prune repeatedly until it returns a partial batch, so that
+// each batch runs in its own short transaction.
+func (w *runReaperWorker) pruneRuns(policy string, batchSize uint32, prune func() (orm.PrunedRuns, error)) {
+	var total int64
+	for {
+		pruned, err := prune()
+		if err != nil {
+			logger.Errorw("RunReaper: unable to prune job runs", "policy", policy, "error", err)
+			return
+		}
+		promRunReaperRowsRemoved.WithLabelValues("job_runs").Add(float64(pruned.JobRuns))
+		promRunReaperRowsRemoved.WithLabelValues("task_runs").Add(float64(pruned.TaskRuns))
+		promRunReaperRowsRemoved.WithLabelValues("run_results").Add(float64(pruned.RunResults))
+		promRunReaperRowsRemoved.WithLabelValues("run_requests").Add(float64(pruned.RunRequests))
+		total += pruned.JobRuns
+		if pruned.JobRuns < int64(batchSize) {
+			break
+		}
+	}
+	if total > 0 {
+		logger.Infow("RunReaper: pruned job runs", "policy", policy, "count", total)
+	}
+}
+
+func (w *runReaperWorker) pruneTable(table string, batchSize uint32, prune func() (int64, error)) {
+	var total int64
+	for {
+		count, err := prune()
+		if err != nil {
+			logger.Errorw("RunReaper: unable to prune", "table", table, "error", err)
+			return
+		}
+		promRunReaperRowsRemoved.WithLabelValues(table).Add(float64(count))
+		total += count
+		if count < int64(batchSize) {
+			break
+		}
+	}
+	if total > 0 {
+		logger.Infow("RunReaper: pruned rows", "table", table, "count", total)
+	}
+}
@@ -12,10 +12,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
-func validateMinimumConfirmations(run *models.JobRun, taskRun *models.TaskRun, currentHeight *utils.Big, txManager store.TxManager) {
-	updateTaskRunConfirmations(currentHeight, run, taskRun)
+func validateMinimumConfirmations(run *models.JobRun, taskRun *models.TaskRun, currentHeight *utils.Big, finalizedHeight *big.Int, txManager store.TxManager) {
+	updateTaskRunConfirmations(currentHeight, finalizedHeight, run, taskRun)
 
-	if !meetsMinimumConfirmations(run, taskRun, run.ObservedHeight) {
+	if !meetsMinimumConfirmations(run, taskRun, run.ObservedHeight, finalizedHeight) {
 		logger.Debugw("Pausing run pending confirmations",
 			run.ForLogger("required_height", taskRun.MinimumConfirmations)...,
 		)
@@ -56,10 +56,14 @@ func validateOnMainChain(run *models.JobRun, taskRun *models.TaskRun, txManager
 	return nil
 }
 
-func updateTaskRunConfirmations(currentHeight *utils.Big, jr *models.JobRun, taskRun *models.TaskRun) {
+func updateTaskRunConfirmations(currentHeight *utils.Big, finalizedHeight *big.Int, jr *models.JobRun, taskRun *models.TaskRun) {
 	if !taskRun.MinimumConfirmations.Valid || jr.CreationHeight == nil || currentHeight == nil {
 		return
 	}
+	if finalizedHeight != nil && jr.CreationHeight.ToInt().Cmp(finalizedHeight) <= 0 {
+		taskRun.Confirmations = taskRun.MinimumConfirmations
+		return
+	}
 
 	confs := blockConfirmations(currentHeight, jr.CreationHeight)
 	diff := utils.MinBigs(confs, big.NewInt(int64(taskRun.MinimumConfirmations.Uint32)))
@@ -74,13 +78,21 @@ func invalidRequest(request models.RunRequest, receipt *eth.TxReceipt) bool {
 		(request.BlockHash != nil && *request.BlockHash != *receipt.BlockHash)
 }
 
+// meetsMinimumConfirmations returns whether the block that created the run is
+// final. If the run's job waits for a finality tag, it is final once the
+// tagged block, at finalizedHeight, has reached it, whatever its number of
+// confirmations. Otherwise the task's minimum confirmations are counted.
 func meetsMinimumConfirmations(
 	run *models.JobRun,
 	taskRun *models.TaskRun,
-	currentHeight *utils.Big) bool {
+	currentHeight *utils.Big,
+	finalizedHeight *big.Int) bool {
 	if !taskRun.MinimumConfirmations.Valid || run.CreationHeight == nil || currentHeight == nil {
 		return true
 	}
+	if finalizedHeight != nil {
+		return run.CreationHeight.ToInt().Cmp(finalizedHeight) <= 0
+	}
 
 	diff := blockConfirmations(currentHeight, run.CreationHeight)
 	return diff.Cmp(big.NewInt(int64(taskRun.MinimumConfirmations.Uint32))) >= 0
@@ -94,3 +106,31 @@ func blockConfirmations(currentHeight, creationHeight *utils.Big) *big.Int {
 	}
 	return confs
 }
+
+// finalityTag returns the block tag the runs of the job wait for to be final,
+// ETH_FINALITY_TAG of the chain unless the job sets its own.
+func finalityTag(chain *store.Store, jobID *models.ID) (models.FinalityTag, error) {
+	tag, err := chain.FindJobFinalityTag(jobID)
+	if err != nil || tag != "" {
+		return tag, err
+	}
+	return chain.Config.EthFinalityTag(), nil
+}
+
+// finalizedHeight returns the number of the block at the finality tag on the
+// chain, or nil if confirmations are counted. If the height can't be
+// fetched, it is notFinalHeight, so that the run waits for the next head.
+func finalizedHeight(chain *store.Store, tag models.FinalityTag) *big.Int {
+	if tag == "" || tag == models.FinalityTagLatest {
+		return nil
+	}
+	height, err := chain.TxManager.FinalizedHeight(tag)
+	if err != nil {
+		logger.Warnw(fmt.Sprintf("Unable to get the %s block, waiting for the next head", tag), "error", err)
+		return notFinalHeight
+	}
+	return height
+}
+
+// notFinalHeight is below every block, so that none is final.
+var notFinalHeight = big.NewInt(-1)
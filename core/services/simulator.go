@@ -0,0 +1,92 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	null "gopkg.in/guregu/null.v3"
+)
+
+// SimulateJob runs the tasks of the given job in memory, feeding them the
+// requestParams as a RunRequest would. HTTP and bridge tasks are performed
+// for real, while adapters implementing adapters.Simulator (e.g. ethtx) only
+// report what they would have done. Nothing is written to the database.
+//
+// The simulation stops at the first task that errors or does not complete
+// synchronously, such as a bridge returning pending.
+func SimulateJob(job models.JobSpec, requestParams models.JSON, store *store.Store) models.JobSimulation {
+	simulation := models.JobSimulation{
+		JobSpecID: job.ID,
+		Status:    models.RunStatusInProgress,
+		TaskRuns:  make([]models.TaskSimulation, 0, len(job.Tasks)),
+	}
+	runID := models.NewID()
+	previousOutput := models.JSON{}
+
+	for _, task := range job.Tasks {
+		ts := simulateTask(runID, task, requestParams, previousOutput, store)
+		simulation.TaskRuns = append(simulation.TaskRuns, ts)
+		if ts.Status != models.RunStatusCompleted {
+			simulation.Status = ts.Status
+			return simulation
+		}
+		previousOutput = ts.Output
+	}
+
+	simulation.Status = models.RunStatusCompleted
+	return simulation
+}
+
+func simulateTask(
+	runID *models.ID,
+	task models.TaskSpec,
+	requestParams models.JSON,
+	previousOutput models.JSON,
+	store *store.Store,
+) models.TaskSimulation {
+	ts := models.TaskSimulation{Task: task}
+
+	params, err := models.Merge(requestParams, task.Params)
+	if err != nil {
+		ts.SetError(err)
+		return ts
+	}
+	task.Params = params
+	ts.Params = params
+
+	adapter, err := adapters.For(task, store.Config, store.ORM)
+	if err != nil {
+		ts.SetError(err)
+		return ts
+	}
+
+	data, err := models.Merge(requestParams, previousOutput)
+	if err != nil {
+		ts.SetError(err)
+		return ts
+	}
+	ts.Input = data
+
+	input := *models.NewRunInput(runID, data, models.RunStatusUnstarted)
+	var output models.RunOutput
+	if simulator, ok := adapter.BaseAdapter.(adapters.Simulator); ok {
+		output = simulator.Simulate(input)
+	} else {
+		output = adapter.Perform(input, store)
+	}
+
+	ts.Metadata = output.Metadata()
+	if output.HasError() {
+		ts.SetError(output.Error())
+		return ts
+	}
+	ts.Output = output.Data()
+	ts.Status = output.Status()
+	if !ts.Status.Completed() {
+		ts.Error = null.StringFrom(fmt.Sprintf("task did not complete synchronously, status %s", ts.Status))
+	}
+	return ts
+}
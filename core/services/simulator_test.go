@@ -0,0 +1,73 @@
+package services_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	server, assertCalled := cltest.NewHTTPMockServer(t, http.StatusOK, "GET", `{"USD": 123.45}`)
+	defer assertCalled()
+
+	address := cltest.NewAddress()
+	j := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "httpgetwithunrestrictednetworkaccess", fmt.Sprintf(`{"get": "%s"}`, server.URL)),
+		cltest.NewTask(t, "jsonparse", `{"path": ["USD"]}`),
+		cltest.NewTask(t, "multiply", `{"times": 100}`),
+		cltest.NewTask(t, "ethuint256"),
+		cltest.NewTask(t, "ethtx", fmt.Sprintf(`{"address": "%s", "functionSelector": "0x12345678"}`, address.Hex())),
+	}
+
+	simulation := services.SimulateJob(j, cltest.JSONFromString(t, `{"extra": true}`), store)
+
+	assert.Equal(t, models.RunStatusCompleted, simulation.Status)
+	require.Len(t, simulation.TaskRuns, 5)
+	for _, ts := range simulation.TaskRuns {
+		assert.Equal(t, models.RunStatusCompleted, ts.Status)
+		assert.False(t, ts.Error.Valid)
+		assert.True(t, ts.Input.Get("extra").Bool())
+	}
+	assert.Equal(t, "12345", simulation.TaskRuns[2].Output.Get("result").String())
+	assert.Equal(t,
+		"0x12345678"+"0000000000000000000000000000000000000000000000000000000000003039",
+		simulation.TaskRuns[4].Output.Get("result").String())
+	assert.Equal(t, address.Hex(), simulation.TaskRuns[4].Output.Get("address").String())
+
+	count, err := store.Unscoped().JobRunsCountFor(j.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestSimulateJob_StopsOnError(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	j := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "jsonparse", `{"path": ["missing", "key"]}`),
+		cltest.NewTask(t, "noop"),
+	}
+
+	simulation := services.SimulateJob(j, cltest.JSONFromString(t, `{"result": "{}"}`), store)
+
+	assert.Equal(t, models.RunStatusErrored, simulation.Status)
+	require.Len(t, simulation.TaskRuns, 1)
+	assert.Equal(t, models.RunStatusErrored, simulation.TaskRuns[0].Status)
+	assert.True(t, simulation.TaskRuns[0].Error.Valid)
+}
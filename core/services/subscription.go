@@ -1,19 +1,20 @@
 package services
 
 import (
-	"context"
 	"fmt"
 	"math/big"
-	"time"
 
 	"github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	ethsvc "github.com/smartcontractkit/chainlink/core/services/eth"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 )
@@ -32,7 +33,7 @@ type JobSubscription struct {
 // StartJobSubscription constructs a JobSubscription which listens for and
 // tracks event logs corresponding to the specified job. Ignores any errors if
 // there is at least one successful subscription to an initiator log.
-func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.Store, runManager RunManager) (JobSubscription, error) {
+func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.Store, runManager RunManager, logBroadcaster ethsvc.LogBroadcaster) (JobSubscription, error) {
 	var merr error
 	var unsubscribers []Unsubscriber
 
@@ -45,7 +46,7 @@ func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.S
 	}
 
 	for _, initr := range initrs {
-		unsubscriber, err := NewInitiatorSubscription(initr, store.TxManager, runManager, nextHead, ReceiveLogRequest)
+		unsubscriber, err := NewInitiatorSubscription(initr, store, runManager, logBroadcaster, nextHead, ReceiveLogRequest)
 		if err == nil {
 			unsubscribers = append(unsubscribers, unsubscriber)
 		} else {
@@ -68,48 +69,139 @@ func (js JobSubscription) Unsubscribe() {
 	}
 }
 
-// InitiatorSubscription encapsulates all functionality needed to wrap an ethereum subscription
-// for use with a Chainlink Initiator. Initiator specific functionality is delegated
-// to the callback.
+// InitiatorSubscription listens for the logs of a Chainlink Initiator on the
+// shared LogBroadcaster, delivering each matching log once. Initiator specific
+// functionality is delegated to the callback.
 type InitiatorSubscription struct {
-	*ManagedSubscription
-	runManager RunManager
-	Initiator  models.Initiator
-	callback   func(RunManager, models.LogRequest)
+	runManager     RunManager
+	Initiator      models.Initiator
+	filter         ethereum.FilterQuery
+	callback       func(RunManager, models.LogRequest)
+	logBroadcaster ethsvc.LogBroadcaster
 }
 
-// NewInitiatorSubscription creates a new InitiatorSubscription that feeds received
-// logs to the callback func parameter.
+var _ ethsvc.BackfillingLogListener = (*InitiatorSubscription)(nil)
+var _ ethsvc.FilteringLogListener = (*InitiatorSubscription)(nil)
+var _ ethsvc.RemovedLogListener = (*InitiatorSubscription)(nil)
+
+// NewInitiatorSubscription registers a new InitiatorSubscription with the
+// LogBroadcaster that feeds received logs to the callback func parameter.
+// Logs are backfilled from the last one the job processed, if that is before
+// nextHead, unless REPLAY_FROM_BLOCK is set.
 func NewInitiatorSubscription(
 	initr models.Initiator,
-	client eth.Client,
+	store *strpkg.Store,
 	runManager RunManager,
+	logBroadcaster ethsvc.LogBroadcaster,
 	nextHead *big.Int,
 	callback func(RunManager, models.LogRequest),
-) (InitiatorSubscription, error) {
+) (*InitiatorSubscription, error) {
+	cursor, err := store.FindLogCursor(models.ContractLogCursorName(initr.JobSpecID, initr.Address))
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return nil, errors.Wrap(err, "NewInitiatorSubscription#FindLogCursor")
+	}
+	if cursor.Initialized && store.Config.ReplayFromBlock() < 0 {
+		atCursor := new(big.Int).SetUint64(cursor.BlockIndex)
+		if nextHead == nil || atCursor.Cmp(nextHead) < 0 {
+			nextHead = atCursor
+		}
+	}
 
 	filter, err := models.FilterQueryFactory(initr, nextHead)
 	if err != nil {
-		return InitiatorSubscription{}, errors.Wrap(err, "NewInitiatorSubscription#FilterQueryFactory")
+		return nil, errors.Wrap(err, "NewInitiatorSubscription#FilterQueryFactory")
 	}
 
-	sub := InitiatorSubscription{
-		runManager: runManager,
-		Initiator:  initr,
-		callback:   callback,
+	sub := &InitiatorSubscription{
+		runManager:     runManager,
+		Initiator:      initr,
+		filter:         filter,
+		callback:       callback,
+		logBroadcaster: logBroadcaster,
 	}
+	logBroadcaster.Register(initr.Address, sub)
 
-	managedSub, err := NewManagedSubscription(client, filter, sub.dispatchLog)
+	loggerLogListening(initr, filter.FromBlock)
+	return sub, nil
+}
+
+// Unsubscribe stops the delivery of logs to the subscription.
+func (sub *InitiatorSubscription) Unsubscribe() {
+	sub.logBroadcaster.Unregister(sub.Initiator.Address, sub)
+}
+
+// OnConnect is a no-op, as missed logs are backfilled by the LogBroadcaster.
+func (sub *InitiatorSubscription) OnConnect() {}
+
+// OnDisconnect is a no-op.
+func (sub *InitiatorSubscription) OnDisconnect() {}
+
+// JobID returns the ID of the initiator's job, which consumes its logs.
+func (sub *InitiatorSubscription) JobID() *models.ID {
+	return sub.Initiator.JobSpecID
+}
+
+// FromBlock returns the block the subscription's logs are backfilled from.
+func (sub *InitiatorSubscription) FromBlock() *big.Int {
+	return sub.filter.FromBlock
+}
+
+// Topics returns the topics of the initiator's filter, so the LogBroadcaster
+// only sends the subscription the logs it initiates runs for.
+func (sub *InitiatorSubscription) Topics() [][]common.Hash {
+	return sub.filter.Topics
+}
+
+// HandleLog dispatches the log if it is within the blocks of the initiator's
+// filter and the job has not consumed it yet.
+func (sub *InitiatorSubscription) HandleLog(lb ethsvc.LogBroadcast, err error) {
 	if err != nil {
-		return sub, errors.Wrap(err, "NewInitiatorSubscription#NewManagedSubscription")
+		logger.Errorw("Error in log subscription", "err", err, "jobID", sub.Initiator.JobSpecID.String())
+		return
 	}
 
-	sub.ManagedSubscription = managedSub
-	loggerLogListening(initr, filter.FromBlock)
-	return sub, nil
+	log := lb.Log().(*eth.Log)
+	if !sub.matches(*log) {
+		return
+	}
+
+	consumed, err := lb.WasAlreadyConsumed()
+	if err != nil {
+		logger.Errorw("Unable to check log consumption", "err", err, "jobID", sub.Initiator.JobSpecID.String())
+		return
+	} else if consumed {
+		return
+	}
+
+	sub.dispatchLog(*log)
+
+	if err := lb.MarkConsumed(); err != nil {
+		logger.Errorw("Unable to mark log consumed", "err", err, "jobID", sub.Initiator.JobSpecID.String())
+	}
+}
+
+// HandleRemovedLog dispatches a log removed by a chain reorganization, so the
+// runs it started are cancelled.
+func (sub *InitiatorSubscription) HandleRemovedLog(lb ethsvc.LogBroadcast) {
+	log := lb.Log().(*eth.Log)
+	if sub.matches(*log) {
+		sub.dispatchLog(*log)
+	}
+}
+
+// matches reports whether the log is within the blocks of the initiator's
+// filter, as the LogBroadcaster shares its subscription between listeners.
+func (sub *InitiatorSubscription) matches(log eth.Log) bool {
+	if sub.filter.FromBlock != nil && new(big.Int).SetUint64(log.BlockNumber).Cmp(sub.filter.FromBlock) < 0 {
+		return false
+	}
+	if sub.filter.ToBlock != nil && new(big.Int).SetUint64(log.BlockNumber).Cmp(sub.filter.ToBlock) > 0 {
+		return false
+	}
+	return true
 }
 
-func (sub InitiatorSubscription) dispatchLog(log eth.Log) {
+func (sub *InitiatorSubscription) dispatchLog(log eth.Log) {
 	logger.Debugw(fmt.Sprintf("Log for %v initiator for job %s", sub.Initiator.Type, sub.Initiator.JobSpecID.String()),
 		"txHash", log.TxHash.Hex(), "logIndex", log.Index, "blockNumber", log.BlockNumber, "job", sub.Initiator.JobSpecID.String())
 
@@ -134,7 +226,10 @@ func ReceiveLogRequest(runManager RunManager, le models.LogRequest) {
 	}
 
 	if le.GetLog().Removed {
-		logger.Debugw("Skipping run for removed log", "log", le.GetLog(), "jobId", le.GetJobSpecID().String())
+		logger.Debugw("Cancelling runs for removed log", "log", le.GetLog(), "jobId", le.GetJobSpecID().String())
+		if err := runManager.CancelRemovedLogRuns(le.GetJobSpecID(), le.GetLog()); err != nil {
+			logger.Errorw(err.Error(), le.ForLogger()...)
+		}
 		return
 	}
 
@@ -170,102 +265,3 @@ func runJob(runManager RunManager, le models.LogRequest) {
 		logger.Errorw(err.Error(), le.ForLogger()...)
 	}
 }
-
-// ManagedSubscription encapsulates the connecting, backfilling, and clean up of an
-// ethereum node subscription.
-type ManagedSubscription struct {
-	logSubscriber   eth.LogSubscriber
-	logs            chan eth.Log
-	ethSubscription eth.Subscription
-	callback        func(eth.Log)
-}
-
-// NewManagedSubscription subscribes to the ethereum node with the passed filter
-// and delegates incoming logs to callback.
-func NewManagedSubscription(
-	logSubscriber eth.LogSubscriber,
-	filter ethereum.FilterQuery,
-	callback func(eth.Log),
-) (*ManagedSubscription, error) {
-	ctx := context.Background()
-	logs := make(chan eth.Log)
-	es, err := logSubscriber.SubscribeToLogs(ctx, logs, filter)
-	if err != nil {
-		return nil, err
-	}
-
-	sub := &ManagedSubscription{
-		logSubscriber:   logSubscriber,
-		callback:        callback,
-		logs:            logs,
-		ethSubscription: es,
-	}
-	go sub.listenToLogs(filter)
-	return sub, nil
-}
-
-// Unsubscribe closes channels and cleans up resources.
-func (sub ManagedSubscription) Unsubscribe() {
-	if sub.ethSubscription != nil {
-		timedUnsubscribe(sub.ethSubscription)
-	}
-	close(sub.logs)
-}
-
-// timedUnsubscribe attempts to unsubscribe but aborts abruptly after a time delay
-// unblocking the application. This is an effort to mitigate the occasional
-// indefinite block described here from go-ethereum:
-// https://chainlink/pull/600#issuecomment-426320971
-func timedUnsubscribe(unsubscriber Unsubscriber) {
-	unsubscribed := make(chan struct{})
-	go func() {
-		unsubscriber.Unsubscribe()
-		close(unsubscribed)
-	}()
-	select {
-	case <-unsubscribed:
-	case <-time.After(100 * time.Millisecond):
-		logger.Warnf("Subscription %T Unsubscribe timed out.", unsubscriber)
-	}
-}
-
-func (sub ManagedSubscription) listenToLogs(q ethereum.FilterQuery) {
-	backfilledSet := sub.backfillLogs(q)
-	for {
-		select {
-		case log, open := <-sub.logs:
-			if !open {
-				return
-			}
-			if _, present := backfilledSet[log.BlockHash.String()]; !present {
-				sub.callback(log)
-			}
-		case err, ok := <-sub.ethSubscription.Err():
-			if ok {
-				logger.Errorw(fmt.Sprintf("Error in log subscription: %s", err.Error()), "err", err)
-			}
-		}
-	}
-}
-
-// Manually retrieve old logs since SubscribeToLogs(logs, filter) only returns newly
-// imported blocks: https://github.com/ethereum/go-ethereum/wiki/RPC-PUB-SUB#logs
-// Therefore TxManager.GetLogs does a one time retrieval of old logs.
-func (sub ManagedSubscription) backfillLogs(q ethereum.FilterQuery) map[string]bool {
-	backfilledSet := map[string]bool{}
-	if q.FromBlock == nil {
-		return backfilledSet
-	}
-
-	logs, err := sub.logSubscriber.GetLogs(q)
-	if err != nil {
-		logger.Errorw("Unable to backfill logs", "err", err, "fromBlock", q.FromBlock.String(), "toBlock", q.ToBlock.String())
-		return backfilledSet
-	}
-
-	for _, log := range logs {
-		backfilledSet[log.BlockHash.String()] = true
-		sub.callback(log)
-	}
-	return backfilledSet
-}
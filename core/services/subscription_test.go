@@ -5,51 +5,47 @@ import (
 	"sync/atomic"
 	"testing"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/golang/mock/gomock"
 
 	ethpkg "github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services"
+	ethsvc "github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
-func TestServices_NewInitiatorSubscription_BackfillLogs(t *testing.T) {
+func TestServices_NewInitiatorSubscription_RegistersWithLogBroadcaster(t *testing.T) {
 	t.Parallel()
 
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
-	eth := cltest.MockEthOnStore(t, store)
 
 	job := cltest.NewJobWithLogInitiator()
 	initr := job.Initiators[0]
-	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
-	eth.Register("eth_getLogs", []ethpkg.Log{log})
-	eth.RegisterSubscription("logs")
 
-	var count int32
-	callback := func(services.RunManager, models.LogRequest) { atomic.AddInt32(&count, 1) }
-	fromBlock := cltest.Head(0)
+	logBroadcaster := new(mocks.LogBroadcaster)
+	logBroadcaster.On("Register", initr.Address, mock.Anything).Return(true).Once()
+
+	callback := func(services.RunManager, models.LogRequest) {}
+	head := cltest.Head(0)
 	jm := new(mocks.RunManager)
-	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, fromBlock.NextInt(), callback)
-	assert.NoError(t, err)
-	defer sub.Unsubscribe()
+	sub, err := services.NewInitiatorSubscription(initr, store, jm, logBroadcaster, head.NextInt(), callback)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), sub.FromBlock())
+	assert.Equal(t, job.ID, sub.JobID())
 
-	eth.EventuallyAllCalled(t)
+	logBroadcaster.On("Unregister", initr.Address, sub).Return().Once()
+	sub.Unsubscribe()
 
-	gomega.NewGomegaWithT(t).Eventually(func() int32 {
-		return atomic.LoadInt32(&count)
-	}).Should(gomega.Equal(int32(1)))
+	logBroadcaster.AssertExpectations(t)
 }
 
 func TestServices_NewInitiatorSubscription_BackfillLogs_WithNoHead(t *testing.T) {
@@ -57,58 +53,109 @@ func TestServices_NewInitiatorSubscription_BackfillLogs_WithNoHead(t *testing.T)
 
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
-	eth := cltest.MockEthOnStore(t, store)
 
 	job := cltest.NewJobWithLogInitiator()
 	initr := job.Initiators[0]
-	eth.RegisterSubscription("logs")
 
-	var count int32
-	callback := func(services.RunManager, models.LogRequest) { atomic.AddInt32(&count, 1) }
+	logBroadcaster := new(mocks.LogBroadcaster)
+	logBroadcaster.On("Register", initr.Address, mock.Anything).Return(true)
+
+	callback := func(services.RunManager, models.LogRequest) {}
 	jm := new(mocks.RunManager)
-	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, nil, callback)
-	assert.NoError(t, err)
-	defer sub.Unsubscribe()
+	sub, err := services.NewInitiatorSubscription(initr, store, jm, logBroadcaster, nil, callback)
+	require.NoError(t, err)
+	assert.Nil(t, sub.FromBlock())
+}
 
-	eth.EventuallyAllCalled(t)
-	assert.Equal(t, int32(0), atomic.LoadInt32(&count))
+func TestServices_NewInitiatorSubscription_BackfillLogs_FromLogCursor(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	initr := job.Initiators[0]
+	cursor := models.LogCursor{
+		Name:        models.ContractLogCursorName(job.ID, initr.Address),
+		Initialized: true,
+		BlockIndex:  5,
+	}
+	require.NoError(t, store.SaveLogCursor(&cursor))
+
+	logBroadcaster := new(mocks.LogBroadcaster)
+	logBroadcaster.On("Register", initr.Address, mock.Anything).Return(true)
+
+	callback := func(services.RunManager, models.LogRequest) {}
+	jm := new(mocks.RunManager)
+	sub, err := services.NewInitiatorSubscription(initr, store, jm, logBroadcaster, cltest.Head(10).NextInt(), callback)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(5), sub.FromBlock())
 }
 
-func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T) {
+func TestServices_InitiatorSubscription_PreventsDoubleDispatch(t *testing.T) {
 	t.Parallel()
 
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
-	eth := cltest.MockEthOnStore(t, store)
 
 	job := cltest.NewJobWithLogInitiator()
 	initr := job.Initiators[0]
 
-	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
-	eth.Register("eth_getLogs", []ethpkg.Log{log}) // backfill
-	logsChan := make(chan ethpkg.Log)
-	eth.RegisterSubscription("logs", logsChan)
+	logBroadcaster := new(mocks.LogBroadcaster)
+	logBroadcaster.On("Register", initr.Address, mock.Anything).Return(true)
 
 	var count int32
 	callback := func(services.RunManager, models.LogRequest) { atomic.AddInt32(&count, 1) }
-	head := cltest.Head(0)
 	jm := new(mocks.RunManager)
-	sub, err := services.NewInitiatorSubscription(initr, store.TxManager, jm, head.NextInt(), callback)
-	assert.NoError(t, err)
-	defer sub.Unsubscribe()
-
-	// Add the same original log
-	logsChan <- log
-	// Add a log after the repeated log to make sure it gets processed
-	log2 := cltest.LogFromFixture(t, "testdata/requestLog0original.json")
-	logsChan <- log2
-
-	eth.EventuallyAllCalled(t)
-	g := gomega.NewGomegaWithT(t)
-	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
+	sub, err := services.NewInitiatorSubscription(initr, store, jm, logBroadcaster, nil, callback)
+	require.NoError(t, err)
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+
+	lb := new(mocks.LogBroadcast)
+	lb.On("Log").Return(&log)
+	lb.On("WasAlreadyConsumed").Return(false, nil).Once()
+	lb.On("MarkConsumed").Return(nil).Once()
+	sub.HandleLog(lb, nil)
+
+	// The same log delivered again, such as by a backfill
+	lb.On("WasAlreadyConsumed").Return(true, nil).Once()
+	sub.HandleLog(lb, nil)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+	lb.AssertExpectations(t)
+}
+
+func TestServices_InitiatorSubscription_HandleRemovedLog(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	initr := job.Initiators[0]
+
+	logBroadcaster := new(mocks.LogBroadcaster)
+	logBroadcaster.On("Register", initr.Address, mock.Anything).Return(true)
+
+	var removed []models.LogRequest
+	callback := func(_ services.RunManager, lr models.LogRequest) { removed = append(removed, lr) }
+	jm := new(mocks.RunManager)
+	sub, err := services.NewInitiatorSubscription(initr, store, jm, logBroadcaster, nil, callback)
+	require.NoError(t, err)
+
+	log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	log.Removed = true
+
+	lb := new(mocks.LogBroadcast)
+	lb.On("Log").Return(&log)
+	sub.HandleRemovedLog(lb)
+
+	require.Len(t, removed, 1)
+	assert.True(t, removed[0].GetLog().Removed)
 }
 
-func TestServices_ReceiveLogRequest_IgnoredLogWithRemovedFlag(t *testing.T) {
+func TestServices_ReceiveLogRequest_CancelsRunsForLogWithRemovedFlag(t *testing.T) {
 	t.Parallel()
 
 	store, cleanup := cltest.NewStore(t)
@@ -128,6 +175,7 @@ func TestServices_ReceiveLogRequest_IgnoredLogWithRemovedFlag(t *testing.T) {
 	require.NoError(t, err)
 
 	jm := new(mocks.RunManager)
+	jm.On("CancelRemovedLogRuns", jobSpec.ID, log.Log).Return(nil)
 	services.ReceiveLogRequest(jm, log)
 	jm.AssertExpectations(t)
 }
@@ -212,33 +260,25 @@ func TestServices_StartJobSubscription(t *testing.T) {
 			store, cleanup := cltest.NewStore(t)
 			defer cleanup()
 
-			eth := cltest.MockEthOnStore(t, store)
-			eth.Register("eth_getLogs", []ethpkg.Log{})
-			logChan := make(chan ethpkg.Log, 1)
-			eth.RegisterSubscription("logs", logChan)
-
 			job := cltest.NewJob()
 			initr := models.Initiator{Type: test.initType}
 			initr.Address = test.initrAddr
 			job.Initiators = []models.Initiator{initr}
 			require.NoError(t, store.CreateJob(&job))
 
-			executeJobChannel := make(chan struct{})
-
 			runManager := new(mocks.RunManager)
-			runManager.On("Create", job.ID, mock.Anything, big.NewInt(0), mock.Anything).
-				Return(nil, nil).
-				Run(func(mock.Arguments) {
-					executeJobChannel <- struct{}{}
-				})
+			runManager.On("Create", job.ID, mock.Anything, big.NewInt(92), mock.Anything).
+				Return(nil, nil)
 
-			subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager)
+			logBroadcaster, listener := expectLogListener(test.initrAddr)
+			subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager, logBroadcaster)
 			require.NoError(t, err)
 			assert.NotNil(t, subscription)
 
-			logChan <- ethpkg.Log{
-				Address: test.logAddr,
-				Data:    ethpkg.UntrustedBytes(test.data),
+			log := ethpkg.Log{
+				Address:     test.logAddr,
+				BlockNumber: 92,
+				Data:        ethpkg.UntrustedBytes(test.data),
 				Topics: []common.Hash{
 					test.topic0,
 					models.IDToTopic(job.ID),
@@ -246,73 +286,41 @@ func TestServices_StartJobSubscription(t *testing.T) {
 					common.BigToHash(big.NewInt(0)),
 				},
 			}
-
-			cltest.CallbackOrTimeout(t, "Create", func() {
-				<-executeJobChannel
-			})
+			lb := new(mocks.LogBroadcast)
+			lb.On("Log").Return(&log)
+			lb.On("WasAlreadyConsumed").Return(false, nil)
+			lb.On("MarkConsumed").Return(nil)
+			(*listener).HandleLog(lb, nil)
 
 			runManager.AssertExpectations(t)
-			eth.EventuallyAllCalled(t)
+			lb.AssertExpectations(t)
 		})
 	}
 }
 
-func TestServices_StartJobSubscription_RunlogNoTopicMatch(t *testing.T) {
+func TestServices_StartJobSubscription_RunLogTopics(t *testing.T) {
 	t.Parallel()
 
-	sharedAddr := cltest.NewAddress()
-
-	tests := []struct {
-		name string
-		data hexutil.Bytes
-	}{
-		{
-			"runlog w non-matching topic",
-			cltest.StringToVersionedLogData20190123withFulfillmentParams(t, "id", `{"value":"100"}`)},
-		{
-			"runlog w non-matching topic",
-			cltest.StringToVersionedLogData20190207withoutIndexes(t, "id", cltest.NewAddress(), `{"value":"100"}`),
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			store, cleanup := cltest.NewStore(t)
-			defer cleanup()
-
-			eth := cltest.MockEthOnStore(t, store)
-			eth.Register("eth_getLogs", []ethpkg.Log{})
-			logChan := make(chan ethpkg.Log, 1)
-			eth.RegisterSubscription("logs", logChan)
-
-			job := cltest.NewJob()
-			initr := models.Initiator{Type: "runlog"}
-			initr.Address = sharedAddr
-			job.Initiators = []models.Initiator{initr}
-			require.NoError(t, store.CreateJob(&job))
-
-			runManager := new(mocks.RunManager)
-			runManager.On("CreateErrored", mock.Anything, mock.Anything, mock.Anything).
-				Return(nil, nil)
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
 
-			subscription, err := services.StartJobSubscription(job, cltest.Head(91), store, runManager)
-			require.NoError(t, err)
-			assert.NotNil(t, subscription)
+	job := cltest.NewJob()
+	initr := models.Initiator{Type: "runlog"}
+	initr.Address = cltest.NewAddress()
+	job.Initiators = []models.Initiator{initr}
+	require.NoError(t, store.CreateJob(&job))
 
-			logChan <- ethpkg.Log{
-				Address: sharedAddr,
-				Data:    ethpkg.UntrustedBytes(test.data),
-				Topics: []common.Hash{
-					common.Hash{},
-					models.IDToTopic(job.ID),
-					cltest.NewAddress().Hash(),
-					common.BigToHash(big.NewInt(0)),
-				},
-			}
+	logBroadcaster, listener := expectLogListener(initr.Address)
+	_, err := services.StartJobSubscription(job, cltest.Head(91), store, new(mocks.RunManager), logBroadcaster)
+	require.NoError(t, err)
 
-			eth.EventuallyAllCalled(t)
-		})
-	}
+	// The LogBroadcaster only sends the subscription the logs with these topics
+	filtering, ok := (*listener).(ethsvc.FilteringLogListener)
+	require.True(t, ok)
+	assert.Equal(t, [][]common.Hash{
+		models.TopicsForInitiatorsWhichRequireJobSpecIDTopic[models.InitiatorRunLog],
+		models.JobSpecIDTopics(job.ID),
+	}, filtering.Topics())
 }
 
 func TestServices_NewInitiatorSubscription_EthLog_ReplayFromBlock(t *testing.T) {
@@ -338,9 +346,6 @@ func TestServices_NewInitiatorSubscription_EthLog_ReplayFromBlock(t *testing.T)
 			store, cleanup := cltest.NewStore(t)
 			defer cleanup()
 
-			txManager := new(mocks.TxManager)
-			store.TxManager = txManager
-
 			currentHead := cltest.Head(test.currentHead)
 
 			store.Config.Set(orm.EnvVarName("ReplayFromBlock"), 10)
@@ -348,33 +353,14 @@ func TestServices_NewInitiatorSubscription_EthLog_ReplayFromBlock(t *testing.T)
 			job := cltest.NewJobWithLogInitiator()
 			job.Initiators[0].InitiatorParams.FromBlock = test.initrParamFromBlock
 
-			expectedQuery := ethereum.FilterQuery{
-				FromBlock: test.wantFromBlock,
-				Addresses: []common.Address{job.Initiators[0].InitiatorParams.Address},
-				Topics:    [][]common.Hash{},
-			}
-
-			log := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
-
-			txManager.On("SubscribeToLogs", mock.Anything, mock.Anything, expectedQuery).Return(cltest.EmptyMockSubscription(), nil)
-			txManager.On("GetLogs", expectedQuery).Return([]ethpkg.Log{log}, nil)
-
-			executeJobChannel := make(chan struct{})
-
-			runManager := new(mocks.RunManager)
-			runManager.On("Create", job.ID, mock.Anything, big.NewInt(int64(log.BlockNumber)), mock.Anything).
-				Return(nil, nil).
-				Run(func(mock.Arguments) {
-					executeJobChannel <- struct{}{}
-				})
-
-			_, err := services.StartJobSubscription(job, currentHead, store, runManager)
+			logBroadcaster, listener := expectLogListener(job.Initiators[0].InitiatorParams.Address)
+			_, err := services.StartJobSubscription(job, currentHead, store, new(mocks.RunManager), logBroadcaster)
 			require.NoError(t, err)
 
-			<-executeJobChannel
-
-			txManager.AssertExpectations(t)
-			runManager.AssertExpectations(t)
+			backfilling, ok := (*listener).(ethsvc.BackfillingLogListener)
+			require.True(t, ok)
+			assert.Equal(t, test.wantFromBlock, backfilling.FromBlock())
+			logBroadcaster.AssertExpectations(t)
 		})
 	}
 }
@@ -397,11 +383,6 @@ func TestServices_NewInitiatorSubscription_RunLog_ReplayFromBlock(t *testing.T)
 			store, cleanup := cltest.NewStore(t)
 			defer cleanup()
 
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-			txmMock := new(mocks.TxManager)
-			store.TxManager = txmMock
-
 			currentHead := cltest.Head(test.currentHead)
 
 			store.Config.Set(orm.EnvVarName("ReplayFromBlock"), 10)
@@ -409,37 +390,43 @@ func TestServices_NewInitiatorSubscription_RunLog_ReplayFromBlock(t *testing.T)
 			job := cltest.NewJobWithRunLogInitiator()
 			initr := job.Initiators[0]
 
-			expectedQuery := ethereum.FilterQuery{
-				FromBlock: test.wantFromBlock,
-				Addresses: []common.Address{initr.InitiatorParams.Address},
-				Topics: [][]common.Hash{
-					models.TopicsForInitiatorsWhichRequireJobSpecIDTopic[models.InitiatorRunLog],
-					{models.IDToTopic(initr.JobSpecID), models.IDToHexTopic(initr.JobSpecID)}},
-			}
-
 			receipt := cltest.TxReceiptFromFixture(t, "../eth/testdata/runlogReceipt.json")
 			log := receipt.Logs[3]
 			log.Topics[1] = models.IDToTopic(job.ID)
 
-			txmMock.On("SubscribeToLogs", mock.Anything, mock.Anything, expectedQuery).Return(cltest.EmptyMockSubscription(), nil)
-			txmMock.On("GetLogs", expectedQuery).Return([]ethpkg.Log{log}, nil)
-
-			executeJobChannel := make(chan struct{})
-
 			runManager := new(mocks.RunManager)
 			runManager.On("Create", job.ID, mock.Anything, big.NewInt(int64(log.BlockNumber)), mock.Anything).
-				Return(nil, nil).
-				Run(func(mock.Arguments) {
-					executeJobChannel <- struct{}{}
-				})
+				Return(nil, nil)
 
-			_, err := services.StartJobSubscription(job, currentHead, store, runManager)
+			logBroadcaster, listener := expectLogListener(initr.InitiatorParams.Address)
+			_, err := services.StartJobSubscription(job, currentHead, store, runManager, logBroadcaster)
 			require.NoError(t, err)
 
-			<-executeJobChannel
+			backfilling, ok := (*listener).(ethsvc.BackfillingLogListener)
+			require.True(t, ok)
+			assert.Equal(t, test.wantFromBlock, backfilling.FromBlock())
+
+			lb := new(mocks.LogBroadcast)
+			lb.On("Log").Return(&log)
+			lb.On("WasAlreadyConsumed").Return(false, nil)
+			lb.On("MarkConsumed").Return(nil)
+			(*listener).HandleLog(lb, nil)
 
 			runManager.AssertExpectations(t)
-			txmMock.AssertExpectations(t)
+			logBroadcaster.AssertExpectations(t)
 		})
 	}
 }
+
+// expectLogListener returns a mock LogBroadcaster expecting a listener to
+// register at the address, and where the listener is stored once it does.
+func expectLogListener(address common.Address) (*mocks.LogBroadcaster, *ethsvc.LogListener) {
+	var listener ethsvc.LogListener
+	logBroadcaster := new(mocks.LogBroadcaster)
+	logBroadcaster.On("Register", address, mock.Anything).
+		Return(true).
+		Run(func(args mock.Arguments) {
+			listener = args.Get(1).(ethsvc.LogListener)
+		})
+	return logBroadcaster, &listener
+}
@@ -89,7 +89,11 @@ func fetchLatestOutgoingTxHash(tr models.TaskRun) (*syncReceiptPresenter, error)
 		receipts := tr.Result.Data.Get("ethereumReceipts")
 		if receipts.IsArray() {
 			arr := receipts.Array()
-			return formatEthereumReceipt(arr[len(arr)-1].String())
+			receipt, err := formatEthereumReceipt(arr[len(arr)-1].String())
+			if receipt != nil {
+				receipt.RevertReason = tr.RevertReason.ValueOrZero()
+			}
+			return receipt, err
 		} else if latestHash := tr.Result.Data.Get("latestOutgoingTxHash").String(); latestHash != "" {
 			return &syncReceiptPresenter{Hash: common.HexToHash(latestHash)}, nil
 		}
@@ -110,8 +114,9 @@ func formatEthereumReceipt(str string) (*syncReceiptPresenter, error) {
 }
 
 type syncReceiptPresenter struct {
-	Hash   common.Hash `json:"transactionHash"`
-	Status TxStatus    `json:"transactionStatus"`
+	Hash         common.Hash `json:"transactionHash"`
+	Status       TxStatus    `json:"transactionStatus"`
+	RevertReason string      `json:"revertReason,omitempty"`
 }
 
 // TxStatus indicates if a transaction is fulfilled or not
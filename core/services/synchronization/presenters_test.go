@@ -152,13 +152,15 @@ func jsonFromFixture(t *testing.T, path string) models.JSON {
 
 func TestSyncJobRunPresenter_EthTxTask(t *testing.T) {
 	tests := []struct {
-		name string
-		path string
-		want string
+		name         string
+		path         string
+		revertReason string
+		want         string
 	}{
-		{"confirmed", "testdata/confirmedEthTxData.json", ""},
-		{"safe fulfilled", "testdata/fulfilledReceiptResponse.json", "fulfilledRunLog"},
-		{"safe not fulfilled", "testdata/notFulfilledReceiptResponse.json", "noFulfilledRunLog"},
+		{"confirmed", "testdata/confirmedEthTxData.json", "", ""},
+		{"safe fulfilled", "testdata/fulfilledReceiptResponse.json", "", "fulfilledRunLog"},
+		{"safe not fulfilled", "testdata/notFulfilledReceiptResponse.json", "", "noFulfilledRunLog"},
+		{"safe reverted", "testdata/notFulfilledReceiptResponse.json", "already fulfilled", "noFulfilledRunLog"},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -181,10 +183,11 @@ func TestSyncJobRunPresenter_EthTxTask(t *testing.T) {
 			run.SetStatus(models.RunStatusCompleted)
 			run.TaskRuns = []models.TaskRun{
 				models.TaskRun{
-					ID:       models.NewID(),
-					TaskSpec: taskSpec,
-					Status:   models.RunStatusPendingConfirmations,
-					Result:   models.RunResult{Data: dataJSON},
+					ID:           models.NewID(),
+					TaskSpec:     taskSpec,
+					Status:       models.RunStatusPendingConfirmations,
+					Result:       models.RunResult{Data: dataJSON},
+					RevertReason: null.NewString(test.revertReason, test.revertReason != ""),
 				},
 			}
 			p := SyncJobRunPresenter{JobRun: &run}
@@ -206,6 +209,7 @@ func TestSyncJobRunPresenter_EthTxTask(t *testing.T) {
 			txresult := task0["result"].Map()
 			assert.Equal(t, test.want, txresult["transactionStatus"].String())
 			assert.Equal(t, outgoingTxHash, txresult["transactionHash"].String())
+			assert.Equal(t, test.revertReason, txresult["revertReason"].String())
 		})
 	}
 }
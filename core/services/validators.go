@@ -29,6 +29,18 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 	if len(j.Initiators) < 1 || len(j.Tasks) < 1 {
 		fe.Add("Must have at least one Initiator and one Task")
 	}
+	if _, err := store.ForChain(j.ChainID); err != nil {
+		fe.Add(err.Error())
+	}
+	if j.FinalityTag != "" {
+		if _, err := models.ParseFinalityTag(string(j.FinalityTag)); err != nil {
+			fe.Add(err.Error())
+		}
+	}
+	if j.RunRateLimit.Valid && j.RunRateLimit.Uint32 > 0 &&
+		j.RunRateLimitInterval.IsInstant() && store.Config.JobRunRateLimitInterval().IsInstant() {
+		fe.Add("runRateLimit requires a runRateLimitInterval greater than 0")
+	}
 	for _, i := range j.Initiators {
 		if err := ValidateInitiator(i, j, store); err != nil {
 			fe.Merge(err)
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -99,6 +100,38 @@ func TestValidateJob_RejectsSleepAdapterWhenExperimentalAdaptersAreDisabled(t *t
 	assert.Error(t, services.ValidateJob(sleepingJob, store))
 }
 
+func TestValidateJob_FinalityTag(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.FinalityTag = models.FinalityTagSafe
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.FinalityTag = "pending"
+	assert.Error(t, services.ValidateJob(job, store))
+}
+
+func TestValidateJob_RunLimits(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.RunRateLimit = clnull.Uint32From(10)
+	job.RunRateLimitInterval = models.MustMakeDuration(time.Minute)
+	job.MaxInFlightRuns = clnull.Uint32From(0)
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	store.Config.Set("JOB_RUN_RATE_LIMIT_INTERVAL", "0s")
+	job.RunRateLimitInterval = models.Duration{}
+	assert.Error(t, services.ValidateJob(job, store))
+
+	job.RunRateLimit = clnull.Uint32From(0)
+	assert.NoError(t, services.ValidateJob(job, store))
+}
+
 func TestValidateBridgeType(t *testing.T) {
 	t.Parallel()
 
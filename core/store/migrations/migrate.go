@@ -47,6 +47,21 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1587975059"
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1588088353"
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1588293486"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1589206996"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1589462363"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1589552014"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1589729485"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1589816211"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1590143710"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1590400000"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1590500000"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1590600000"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1590700000"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1590800000"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1590900000"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1591000000"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1591100000"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1591200000"
 
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -232,6 +247,66 @@ func init() {
 			ID:      "1588088353",
 			Migrate: migration1588088353.Migrate,
 		},
+		{
+			ID:      "1589206996",
+			Migrate: migration1589206996.Migrate,
+		},
+		{
+			ID:      "1589462363",
+			Migrate: migration1589462363.Migrate,
+		},
+		{
+			ID:      "1589552014",
+			Migrate: migration1589552014.Migrate,
+		},
+		{
+			ID:      "1589729485",
+			Migrate: migration1589729485.Migrate,
+		},
+		{
+			ID:      "1589816211",
+			Migrate: migration1589816211.Migrate,
+		},
+		{
+			ID:      "1590143710",
+			Migrate: migration1590143710.Migrate,
+		},
+		{
+			ID:      "1590400000",
+			Migrate: migration1590400000.Migrate,
+		},
+		{
+			ID:      "1590500000",
+			Migrate: migration1590500000.Migrate,
+		},
+		{
+			ID:      "1590600000",
+			Migrate: migration1590600000.Migrate,
+		},
+		{
+			ID:      "1590700000",
+			Migrate: migration1590700000.Migrate,
+		},
+		{
+			ID:      "1590800000",
+			Migrate: migration1590800000.Migrate,
+		},
+		{
+			ID:      "1590900000",
+			Migrate: migration1590900000.Migrate,
+		},
+		{
+			ID:      "1591000000",
+			Migrate: migration1591000000.Migrate,
+		},
+		{
+			ID:      "1591100000",
+			Migrate: migration1591100000.Migrate,
+		},
+		{
+			ID:      "1591200000",
+			Migrate: migration1591200000.Migrate,
+		},
 	}
 }
 
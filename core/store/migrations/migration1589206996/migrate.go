@@ -0,0 +1,16 @@
+package migration1589206996
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the resolved params, input, adapter metadata and time spent
+// executing to task_runs, so that each run records a trace of its tasks
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE task_runs ADD COLUMN "params" text;
+	  ALTER TABLE task_runs ADD COLUMN "input" text;
+	  ALTER TABLE task_runs ADD COLUMN "metadata" text;
+	  ALTER TABLE task_runs ADD COLUMN "elapsed" bigint NOT NULL DEFAULT 0;
+	`).Error
+}
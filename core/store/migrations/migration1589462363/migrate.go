@@ -0,0 +1,14 @@
+package migration1589462363
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds an optional idempotency key to run_requests, so that duplicate
+// requests to run a job can be collapsed into the first run
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE run_requests ADD COLUMN "idempotency_key" text;
+	  CREATE INDEX idx_run_requests_idempotency_key ON run_requests(idempotency_key) WHERE idempotency_key IS NOT NULL;
+	`).Error
+}
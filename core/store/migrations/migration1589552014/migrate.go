@@ -0,0 +1,14 @@
+package migration1589552014
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the gas used and ETH spent by the transactions of each job
+// run, so that the cost of a job can be weighed against its earnings
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE job_runs ADD COLUMN "gas_used" bigint NOT NULL DEFAULT 0;
+	  ALTER TABLE job_runs ADD COLUMN "eth_spent" numeric(78,0);
+	`).Error
+}
@@ -0,0 +1,16 @@
+package migration1589729485
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the max priority fee and max fee of EIP-1559 transactions to
+// txes and tx_attempts. Both are null for legacy transactions
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE txes ADD COLUMN "gas_tip_cap" varchar(78);
+	  ALTER TABLE txes ADD COLUMN "gas_fee_cap" varchar(78);
+	  ALTER TABLE tx_attempts ADD COLUMN "gas_tip_cap" varchar(78);
+	  ALTER TABLE tx_attempts ADD COLUMN "gas_fee_cap" varchar(78);
+	`).Error
+}
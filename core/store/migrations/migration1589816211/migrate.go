@@ -0,0 +1,14 @@
+package migration1589816211
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the reason a mined transaction reverted with to txes, and to
+// the task_runs that sent them
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE txes ADD COLUMN "revert_reason" text;
+	  ALTER TABLE task_runs ADD COLUMN "revert_reason" text;
+	`).Error
+}
@@ -0,0 +1,15 @@
+package migration1590143710
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the chain a job runs on to job_specs, and the chain of each
+// head to heads. Both are NULL for the primary chain.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE job_specs ADD COLUMN "chain_id" varchar(78);
+	  ALTER TABLE heads ADD COLUMN "chain_id" varchar(78);
+	  CREATE INDEX idx_heads_chain_id ON heads(chain_id);
+	`).Error
+}
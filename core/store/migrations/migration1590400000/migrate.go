@@ -0,0 +1,14 @@
+package migration1590400000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the parent hash of each head, so the head tracker can tell
+// when the chain reorganizes. Heads saved before have a zero parent hash.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE heads ADD COLUMN "parent_hash" bytea NOT NULL DEFAULT '\x0000000000000000000000000000000000000000000000000000000000000000';
+	  ALTER TABLE heads ALTER COLUMN "parent_hash" DROP DEFAULT;
+	`).Error
+}
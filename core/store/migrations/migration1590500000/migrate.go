@@ -0,0 +1,20 @@
+package migration1590500000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate brings back the log_cursors table, to record the last log each
+// subscription processed so that logs missed while the node was down can be
+// backfilled.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  CREATE TABLE log_cursors (
+		"name" text PRIMARY KEY,
+		"initialized" boolean NOT NULL DEFAULT true,
+		"block_index" bigint NOT NULL DEFAULT 0,
+		"log_index" bigint NOT NULL DEFAULT 0,
+		"updated_at" timestamptz NOT NULL
+	  );
+	`).Error
+}
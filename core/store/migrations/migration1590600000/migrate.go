@@ -0,0 +1,15 @@
+package migration1590600000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the timestamp of each head's block and the time the node
+// received it, so the latency of the Ethereum node can be reported. Heads
+// saved before have no timestamp.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE heads ADD COLUMN "timestamp" timestamptz;
+	  ALTER TABLE heads ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT now();
+	`).Error
+}
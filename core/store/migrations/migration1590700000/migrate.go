@@ -0,0 +1,13 @@
+package migration1590700000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the block tag a job's runs wait for to be final to job_specs.
+// It is empty for jobs using ETH_FINALITY_TAG of their chain.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE job_specs ADD COLUMN "finality_tag" text NOT NULL DEFAULT '';
+	`).Error
+}
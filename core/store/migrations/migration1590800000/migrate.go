@@ -0,0 +1,14 @@
+package migration1590800000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the chain each transaction was sent on to txes. It is NULL
+// for the primary chain, which every existing transaction was sent on.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE txes ADD COLUMN "chain_id" varchar(78);
+	  CREATE INDEX idx_txes_chain_id ON txes(chain_id);
+	`).Error
+}
@@ -0,0 +1,14 @@
+package migration1590900000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the block a safe attempt was mined in to tx_attempts, so that
+// its transaction can be checked again when a reorg orphans that block.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE tx_attempts ADD COLUMN "receipt_block_number" bigint;
+	  CREATE INDEX idx_tx_attempts_receipt_block_number ON tx_attempts(receipt_block_number);
+	`).Error
+}
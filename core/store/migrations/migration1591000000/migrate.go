@@ -0,0 +1,16 @@
+package migration1591000000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the run limits of a job to job_specs. They are NULL, or 0 for
+// the interval, for jobs using JOB_MAX_IN_FLIGHT_RUNS, JOB_RUN_RATE_LIMIT and
+// JOB_RUN_RATE_LIMIT_INTERVAL.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE job_specs ADD COLUMN "max_in_flight_runs" bigint;
+	  ALTER TABLE job_specs ADD COLUMN "run_rate_limit" bigint;
+	  ALTER TABLE job_specs ADD COLUMN "run_rate_limit_interval" bigint NOT NULL DEFAULT 0;
+	`).Error
+}
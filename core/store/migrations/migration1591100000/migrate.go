@@ -0,0 +1,14 @@
+package migration1591100000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate flags the attempts that cancel their transaction, so that the
+// transaction keeps the recipient, data, value and gas limit it was created
+// with.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE tx_attempts ADD COLUMN "cancellation" boolean NOT NULL DEFAULT false;
+	`).Error
+}
@@ -0,0 +1,13 @@
+package migration1591200000
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate records on each transaction the block tag it waits for to be
+// final, that of the job whose run sent it.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	  ALTER TABLE txes ADD COLUMN "finality_tag" text NOT NULL DEFAULT '';
+	`).Error
+}
@@ -152,6 +152,8 @@ func (j JSON) Value() (driver.Value, error) {
 // Scan reads the database value and returns an instance.
 func (j *JSON) Scan(value interface{}) error {
 	switch v := value.(type) {
+	case nil:
+		*j = JSON{}
 	case string:
 		*j = JSON{Result: gjson.Parse(v)}
 	case []byte:
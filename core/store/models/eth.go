@@ -9,7 +9,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/jinzhu/gorm"
 	null "gopkg.in/guregu/null.v3"
 )
 
@@ -31,15 +30,50 @@ type Tx struct {
 	Nonce    uint64         `gorm:"index;not null"`
 	Value    *utils.Big     `gorm:"not null"`
 	GasLimit uint64         `gorm:"not null"`
+	// ChainID is the chain the transaction was sent on when it is one of
+	// ETH_CHAINS, nil on the primary chain.
+	ChainID *utils.Big `gorm:"type:varchar(78);index"`
+	// FinalityTag is the block tag the transaction waits for to be final,
+	// that of the job whose run sent it. ETH_FINALITY_TAG when empty.
+	FinalityTag FinalityTag `gorm:"not null;default:''"`
 
 	// TxAttempt fields manually included; can't embed another primary_key
+	// GasTipCap and GasFeeCap are only set for EIP-1559 transactions, in
+	// which case GasPrice holds the fee cap
 	Hash        common.Hash `gorm:"not null"`
 	GasPrice    *utils.Big  `gorm:"not null"`
+	GasTipCap   *utils.Big  `gorm:"type:varchar(78)"`
+	GasFeeCap   *utils.Big  `gorm:"type:varchar(78)"`
 	Confirmed   bool        `gorm:"not null"`
 	SentAt      uint64      `gorm:"not null"`
 	SignedRawTx []byte      `gorm:"not null"`
 	CreatedAt   time.Time   `json:"-"`
 	UpdatedAt   time.Time   `json:"-"`
+
+	// RevertReason is the reason the mined transaction reverted with, found by
+	// replaying it
+	RevertReason null.String
+}
+
+// attempt returns the attempt of the transaction with the hash, or nil.
+func (tx *Tx) attempt(hash common.Hash) *TxAttempt {
+	for _, attempt := range tx.Attempts {
+		if attempt.Hash == hash {
+			return attempt
+		}
+	}
+	return nil
+}
+
+// Cancelled returns true if the transaction was cancelled, in which case its
+// later attempts are zero value transactions from its sender to itself
+func (tx *Tx) Cancelled() bool {
+	for _, attempt := range tx.Attempts {
+		if attempt.Cancellation {
+			return true
+		}
+	}
+	return false
 }
 
 // String implements Stringer for Tx
@@ -79,10 +113,19 @@ type TxAttempt struct {
 
 	Hash        common.Hash `gorm:"index;not null"`
 	GasPrice    *utils.Big  `gorm:"type:varchar(78);not null"`
+	GasTipCap   *utils.Big  `gorm:"type:varchar(78)"`
+	GasFeeCap   *utils.Big  `gorm:"type:varchar(78)"`
 	Confirmed   bool        `gorm:"not null"`
 	SentAt      uint64      `gorm:"not null"`
 	SignedRawTx []byte      `gorm:"not null"`
 	UpdatedAt   time.Time   `json:"-"`
+
+	// ReceiptBlockNumber is the block the attempt was mined in, set once it
+	// is safe
+	ReceiptBlockNumber null.Int
+	// Cancellation is set on attempts that replace the transaction with a
+	// zero value transaction from its sender to itself
+	Cancellation bool `gorm:"not null;default:false"`
 }
 
 // String implements Stringer for TxAttempt
@@ -131,30 +174,44 @@ func HighestPricedTxAttemptPerTx(items []TxAttempt) []TxAttempt {
 	return highestPriced
 }
 
+// FinalityTag is the block tag, as in eth_getBlockByNumber, of the newest
+// block that is considered final.
+type FinalityTag string
+
+const (
+	// FinalityTagLatest counts block confirmations to decide a block is final.
+	FinalityTagLatest FinalityTag = "latest"
+	// FinalityTagSafe waits for the safe block, justified by the beacon chain.
+	FinalityTagSafe FinalityTag = "safe"
+	// FinalityTagFinalized waits for the finalized block of the beacon chain.
+	FinalityTagFinalized FinalityTag = "finalized"
+)
+
+// ParseFinalityTag returns the finality tag of the string, erroring if it is
+// not one of latest, safe or finalized.
+func ParseFinalityTag(str string) (FinalityTag, error) {
+	tag := FinalityTag(str)
+	switch tag {
+	case FinalityTagLatest, FinalityTagSafe, FinalityTagFinalized:
+		return tag, nil
+	}
+	return "", fmt.Errorf("Unknown finality tag '%s', must be one of %s, %s or %s",
+		str, FinalityTagLatest, FinalityTagSafe, FinalityTagFinalized)
+}
+
 // Head represents a BlockNumber, BlockHash.
 type Head struct {
-	ID     uint64      `gorm:"primary_key;auto_increment"`
-	Hash   common.Hash `gorm:"not null"`
-	Number int64       `gorm:"index;not null"`
-}
-
-// AfterCreate is a gorm hook that trims heads after its creation
-func (h Head) AfterCreate(scope *gorm.Scope) (err error) {
-	scope.DB().Exec(`
-	DELETE FROM heads
-	WHERE id <= (
-	  SELECT id
-	  FROM (
-		SELECT id
-		FROM heads
-		ORDER BY id DESC
-		LIMIT 1 OFFSET 100
-	  ) foo
-	)`)
-	if err != nil {
-		return err
-	}
-	return nil
+	ID         uint64      `gorm:"primary_key;auto_increment"`
+	Hash       common.Hash `gorm:"not null"`
+	ParentHash common.Hash `gorm:"not null"`
+	Number     int64       `gorm:"index;not null"`
+	// ChainID is the chain of the head when it is on one of ETH_CHAINS, nil
+	// on the primary chain.
+	ChainID *utils.Big `gorm:"type:varchar(78);index"`
+	// Timestamp is the time of the head's block, if known.
+	Timestamp null.Time
+	// CreatedAt is the time the node received the head.
+	CreatedAt time.Time
 }
 
 // NewHead returns a Head instance with a BlockNumber and BlockHash.
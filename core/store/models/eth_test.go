@@ -75,6 +75,32 @@ func TestHead_NextInt(t *testing.T) {
 	}
 }
 
+func TestParseFinalityTag(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input   string
+		want    models.FinalityTag
+		wantErr bool
+	}{
+		{"latest", models.FinalityTagLatest, false},
+		{"safe", models.FinalityTagSafe, false},
+		{"finalized", models.FinalityTagFinalized, false},
+		{"pending", "", true},
+		{"", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			tag, err := models.ParseFinalityTag(test.input)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.want, tag)
+		})
+	}
+}
+
 func TestTx_PresenterMatchesHex(t *testing.T) {
 	t.Parallel()
 
@@ -1,11 +1,13 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/eth"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
@@ -44,6 +46,8 @@ type JobRun struct {
 	ObservedHeight *utils.Big    `json:"observedHeight"`
 	DeletedAt      null.Time     `json:"-"`
 	Payment        *assets.Link  `json:"payment,omitempty"`
+	GasUsed        uint64        `json:"gasUsed"`
+	EthSpent       *assets.Eth   `json:"ethSpent,omitempty" gorm:"type:numeric(78,0)"`
 }
 
 // MakeJobRun returns a new JobRun copy
@@ -197,6 +201,7 @@ func (jr *JobRun) Cancel() {
 
 // ApplyOutput updates the JobRun's Result and Status
 func (jr *JobRun) ApplyOutput(result RunOutput) {
+	jr.addTxCost(result.GasUsed(), result.EthSpent())
 	if result.HasError() {
 		jr.SetError(result.Error())
 		return
@@ -205,6 +210,101 @@ func (jr *JobRun) ApplyOutput(result RunOutput) {
 	jr.SetStatus(result.Status())
 }
 
+// addTxCost accumulates the cost of a transaction sent by one of the run's
+// tasks.
+func (jr *JobRun) addTxCost(gasUsed uint64, ethSpent *assets.Eth) {
+	jr.GasUsed += gasUsed
+	if ethSpent == nil {
+		return
+	}
+	if jr.EthSpent == nil {
+		jr.EthSpent = assets.NewEth(0)
+	}
+	jr.EthSpent = new(assets.Eth).Add(jr.EthSpent, ethSpent)
+}
+
+// ReopenForReorg moves the run back to pending confirmations on the task that
+// sent the transaction, whose receipt was in a block orphaned by a chain
+// reorganization. The orphaned receipt and its cost are dropped, and the
+// tasks after it run again once the transaction is safe. It returns false if
+// the run was cancelled or errored, or none of its completed tasks sent the
+// transaction.
+func (jr *JobRun) ReopenForReorg(tx *Tx) (bool, error) {
+	if jr.Status.Cancelled() || jr.Status.Errored() {
+		return false, nil
+	}
+	index := -1
+	for i, tr := range jr.TaskRuns {
+		hash := common.HexToHash(tr.Result.Data.Get("result").String())
+		if tr.Status.Completed() && tx.attempt(hash) != nil {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return false, nil
+	}
+
+	taskRun := &jr.TaskRuns[index]
+	var receipts, kept []eth.TxReceipt
+	if str := taskRun.Result.Data.Get("ethereumReceipts").String(); str != "" {
+		if err := json.Unmarshal([]byte(str), &receipts); err != nil {
+			return false, err
+		}
+	}
+	for _, receipt := range receipts {
+		if attempt := tx.attempt(receipt.Hash); attempt != nil {
+			jr.removeTxCost(receipt, attempt)
+		} else {
+			kept = append(kept, receipt)
+		}
+	}
+	data, err := taskRun.Result.Data.Add("ethereumReceipts", kept)
+	if err != nil {
+		return false, err
+	}
+	taskRun.Result.Data = data
+	taskRun.Status = RunStatusPendingConfirmations
+
+	for i := index + 1; i < len(jr.TaskRuns); i++ {
+		jr.TaskRuns[i].Status = RunStatusUnstarted
+		jr.TaskRuns[i].Result.Data = JSON{}
+		jr.TaskRuns[i].Result.ErrorMessage = null.String{}
+	}
+	jr.FinishedAt = null.Time{}
+	jr.SetStatus(RunStatusPendingConfirmations)
+	return true, nil
+}
+
+// removeTxCost takes the cost of a transaction whose receipt was orphaned out
+// of the run's.
+func (jr *JobRun) removeTxCost(receipt eth.TxReceipt, attempt *TxAttempt) {
+	if receipt.GasUsed == nil {
+		return
+	}
+	gasUsed := receipt.GasUsed.ToInt()
+	if gasUsed.IsUint64() && gasUsed.Uint64() <= jr.GasUsed {
+		jr.GasUsed -= gasUsed.Uint64()
+	} else {
+		jr.GasUsed = 0
+	}
+	if jr.EthSpent == nil {
+		return
+	}
+	price := attempt.GasPrice
+	if receipt.EffectiveGasPrice != nil {
+		price = receipt.EffectiveGasPrice
+	}
+	if price == nil {
+		return
+	}
+	ethSpent := new(big.Int).Mul(gasUsed, price.ToInt())
+	jr.EthSpent = (*assets.Eth)(new(big.Int).Sub(jr.EthSpent.ToInt(), ethSpent))
+	if jr.EthSpent.ToInt().Sign() < 0 {
+		jr.EthSpent = assets.NewEth(0)
+	}
+}
+
 // ApplyBridgeRunResult saves the input from a BridgeAdapter
 func (jr *JobRun) ApplyBridgeRunResult(result BridgeRunResult) {
 	if result.HasError() {
@@ -229,6 +329,10 @@ type RunRequest struct {
 	CreatedAt     time.Time
 	Payment       *assets.Link
 	RequestParams JSON `gorm:"default: '{}';not null"`
+	// IdempotencyKey is optionally supplied by the requester. Requests for the
+	// same job with the same key within RunRequestDedupWindow are collapsed
+	// into the first run.
+	IdempotencyKey null.String
 }
 
 // NewRunRequest returns a new RunRequest instance.
@@ -248,6 +352,11 @@ type TaskRun struct {
 	TaskSpecID           uint          `json:"-"`
 	MinimumConfirmations clnull.Uint32 `json:"minimumConfirmations"`
 	Confirmations        clnull.Uint32 `json:"confirmations"`
+	Params               JSON          `json:"params" gorm:"type:text"`
+	Input                JSON          `json:"input" gorm:"type:text"`
+	Metadata             JSON          `json:"metadata" gorm:"type:text"`
+	Elapsed              Duration      `json:"elapsed"`
+	RevertReason         null.String   `json:"revertReason"`
 	CreatedAt            time.Time     `json:"-"`
 	UpdatedAt            time.Time     `json:"-"`
 }
@@ -272,8 +381,14 @@ func (tr *TaskRun) ApplyBridgeRunResult(result BridgeRunResult) {
 	tr.Status = result.Status
 }
 
-// ApplyOutput updates the TaskRun's Result and Status
+// ApplyOutput updates the TaskRun's Result, Status and adapter Metadata
 func (tr *TaskRun) ApplyOutput(result RunOutput) {
+	if result.Metadata().Exists() {
+		tr.Metadata = result.Metadata()
+	}
+	if result.RevertReason().Valid {
+		tr.RevertReason = result.RevertReason()
+	}
 	if result.HasError() {
 		tr.SetError(result.Error())
 		return
@@ -206,3 +206,63 @@ func TestJobRun_ApplyOutput_ErrorSetsFinishedAt(t *testing.T) {
 	jobRun.ApplyOutput(result)
 	assert.True(t, jobRun.FinishedAt.Valid)
 }
+
+func TestJobRun_ApplyOutput_AccumulatesTxCost(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	jobRun := cltest.NewJobRun(job)
+
+	jobRun.ApplyOutput(models.NewRunOutputPendingConfirmationsWithData(models.JSON{}))
+	assert.Equal(t, uint64(0), jobRun.GasUsed)
+	assert.Nil(t, jobRun.EthSpent)
+
+	result := models.NewRunOutputComplete(models.JSON{}).WithTxCost(21000, assets.NewEth(21000000))
+	jobRun.ApplyOutput(result)
+	jobRun.ApplyOutput(result)
+	assert.Equal(t, uint64(42000), jobRun.GasUsed)
+	assert.Equal(t, assets.NewEth(42000000), jobRun.EthSpent)
+}
+
+func TestJobRun_ReopenForReorg(t *testing.T) {
+	t.Parallel()
+
+	orphanedHash := cltest.NewHash()
+	tx := &models.Tx{Attempts: []*models.TxAttempt{{Hash: orphanedHash, GasPrice: utils.NewBig(big.NewInt(1000))}}}
+	otherHash := cltest.NewHash()
+
+	job := cltest.NewJobWithWebInitiator()
+	jobRun := cltest.NewJobRun(job)
+	jobRun.TaskRuns = []models.TaskRun{
+		{Status: models.RunStatusCompleted, Result: models.RunResult{Data: cltest.JSONFromString(t,
+			`{"result": "%s", "ethereumReceipts": [{"transactionHash": "%s", "gasUsed": "0x5208"}, {"transactionHash": "%s", "gasUsed": "0x5208"}]}`,
+			orphanedHash.Hex(), otherHash.Hex(), orphanedHash.Hex())}},
+		{Status: models.RunStatusCompleted, Result: models.RunResult{Data: cltest.JSONFromString(t, `{"result": "done"}`)}},
+	}
+	jobRun.GasUsed = 42000
+	jobRun.EthSpent = assets.NewEth(42000000)
+	jobRun.SetStatus(models.RunStatusCompleted)
+	require.True(t, jobRun.FinishedAt.Valid)
+
+	reopened, err := jobRun.ReopenForReorg(&models.Tx{Attempts: []*models.TxAttempt{{Hash: cltest.NewHash()}}})
+	require.NoError(t, err)
+	assert.False(t, reopened)
+	assert.Equal(t, models.RunStatusCompleted, jobRun.GetStatus())
+
+	reopened, err = jobRun.ReopenForReorg(tx)
+	require.NoError(t, err)
+	assert.True(t, reopened)
+	assert.Equal(t, models.RunStatusPendingConfirmations, jobRun.GetStatus())
+	assert.False(t, jobRun.FinishedAt.Valid)
+	assert.Equal(t, uint64(21000), jobRun.GasUsed)
+	assert.Equal(t, assets.NewEth(21000000), jobRun.EthSpent)
+
+	assert.Equal(t, models.RunStatusPendingConfirmations, jobRun.TaskRuns[0].Status)
+	assert.Equal(t, orphanedHash.Hex(), jobRun.TaskRuns[0].Result.Data.Get("result").String())
+	receipts := jobRun.TaskRuns[0].Result.Data.Get("ethereumReceipts").Array()
+	require.Len(t, receipts, 1)
+	assert.Equal(t, otherHash.Hex(), receipts[0].Get("transactionHash").String())
+
+	assert.Equal(t, models.RunStatusUnstarted, jobRun.TaskRuns[1].Status)
+	assert.Equal(t, models.JSON{}, jobRun.TaskRuns[1].Result.Data)
+}
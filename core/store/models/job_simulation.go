@@ -0,0 +1,54 @@
+package models
+
+import (
+	null "gopkg.in/guregu/null.v3"
+)
+
+// JobSimulationRequest is the body of a request to dry-run a job spec
+// against the given request data, without creating the job.
+type JobSimulationRequest struct {
+	JobSpec JobSpecRequest `json:"jobSpec"`
+	Data    JSON           `json:"data"`
+}
+
+// JobSimulation holds the outcome of simulating each of a job spec's tasks.
+// It is never persisted.
+type JobSimulation struct {
+	JobSpecID *ID              `json:"jobId"`
+	Status    RunStatus        `json:"status"`
+	TaskRuns  []TaskSimulation `json:"taskRuns"`
+}
+
+// TaskSimulation records the resolved params, the input handed to the
+// adapter and the output it produced for a single simulated task.
+type TaskSimulation struct {
+	Task     TaskSpec    `json:"task"`
+	Params   JSON        `json:"params"`
+	Input    JSON        `json:"input"`
+	Output   JSON        `json:"output"`
+	Metadata JSON        `json:"metadata"`
+	Status   RunStatus   `json:"status"`
+	Error    null.String `json:"error"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (js JobSimulation) GetID() string {
+	return js.JobSpecID.String()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (js JobSimulation) GetName() string {
+	return "simulations"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (js *JobSimulation) SetID(value string) error {
+	js.JobSpecID = new(ID)
+	return js.JobSpecID.UnmarshalText([]byte(value))
+}
+
+// SetError marks this task simulation as failed and saves the error message.
+func (ts *TaskSimulation) SetError(err error) {
+	ts.Error = null.StringFrom(err.Error())
+	ts.Status = RunStatusErrored
+}
@@ -20,11 +20,17 @@ import (
 
 // JobSpecRequest represents a schema for the incoming job spec request as used by the API.
 type JobSpecRequest struct {
-	Initiators []InitiatorRequest `json:"initiators"`
-	Tasks      []TaskSpecRequest  `json:"tasks"`
-	StartAt    null.Time          `json:"startAt"`
-	EndAt      null.Time          `json:"endAt"`
-	MinPayment *assets.Link       `json:"minPayment,omitempty"`
+	Initiators  []InitiatorRequest `json:"initiators"`
+	Tasks       []TaskSpecRequest  `json:"tasks"`
+	StartAt     null.Time          `json:"startAt"`
+	EndAt       null.Time          `json:"endAt"`
+	MinPayment  *assets.Link       `json:"minPayment,omitempty"`
+	ChainID     *utils.Big         `json:"chainId,omitempty"`
+	FinalityTag FinalityTag        `json:"finalityTag,omitempty"`
+
+	MaxInFlightRuns      clnull.Uint32 `json:"maxInFlightRuns"`
+	RunRateLimit         clnull.Uint32 `json:"runRateLimit"`
+	RunRateLimitInterval Duration      `json:"runRateLimitInterval,omitempty"`
 }
 
 // InitiatorRequest represents a schema for incoming initiator requests as used by the API.
@@ -53,6 +59,21 @@ type JobSpec struct {
 	EndAt      null.Time    `json:"endAt" gorm:"index"`
 	DeletedAt  null.Time    `json:"-" gorm:"index"`
 	UpdatedAt  time.Time    `json:"-"`
+	// ChainID is the chain in ETH_CHAINS the job runs on, nil for the
+	// primary chain.
+	ChainID *utils.Big `json:"chainId,omitempty" gorm:"type:varchar(78)"`
+	// FinalityTag overrides ETH_FINALITY_TAG of the chain for the job's runs
+	// if set.
+	FinalityTag FinalityTag `json:"finalityTag,omitempty"`
+	// MaxInFlightRuns overrides JOB_MAX_IN_FLIGHT_RUNS for the job if set,
+	// with 0 for no limit.
+	MaxInFlightRuns clnull.Uint32 `json:"maxInFlightRuns"`
+	// RunRateLimit overrides JOB_RUN_RATE_LIMIT for the job if set, with 0
+	// for no limit.
+	RunRateLimit clnull.Uint32 `json:"runRateLimit"`
+	// RunRateLimitInterval overrides JOB_RUN_RATE_LIMIT_INTERVAL for the job
+	// if not 0.
+	RunRateLimitInterval Duration `json:"runRateLimitInterval,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -99,6 +120,11 @@ func NewJobFromRequest(jsr JobSpecRequest) JobSpec {
 	jobSpec.EndAt = jsr.EndAt
 	jobSpec.StartAt = jsr.StartAt
 	jobSpec.MinPayment = jsr.MinPayment
+	jobSpec.ChainID = jsr.ChainID
+	jobSpec.FinalityTag = jsr.FinalityTag
+	jobSpec.MaxInFlightRuns = jsr.MaxInFlightRuns
+	jobSpec.RunRateLimit = jsr.RunRateLimit
+	jobSpec.RunRateLimitInterval = jsr.RunRateLimitInterval
 	return jobSpec
 }
 
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/eth"
@@ -588,9 +589,48 @@ func IDToHexTopic(id *ID) common.Hash {
 	return common.BytesToHash([]byte(id.String()))
 }
 
+// LogCursor records the last log a subscription processed, so the logs it
+// missed while the node was down can be fetched when it reconnects.
 type LogCursor struct {
 	Name        string `gorm:"primary_key"`
 	Initialized bool   `gorm:"not null;default true"`
 	BlockIndex  uint64 `gorm:"not null;default 0"`
 	LogIndex    uint64 `gorm:"not null;default 0"`
+	UpdatedAt   time.Time
+}
+
+// ContractLogCursorName is the name of the cursor of a job's logs from a
+// contract.
+func ContractLogCursorName(jobID *ID, address common.Address) string {
+	return fmt.Sprintf("contract-%s-%s", jobID.String(), address.Hex())
+}
+
+// Advance moves the cursor to the log if it is a later one, and reports
+// whether it moved. Removed logs move the cursor back to the block before
+// theirs if it was past it, since that block is no longer part of the chain.
+func (c *LogCursor) Advance(log eth.Log) bool {
+	if log.Removed {
+		if !c.Initialized || c.BlockIndex < log.BlockNumber || log.BlockNumber == 0 {
+			return false
+		}
+		c.BlockIndex, c.LogIndex = log.BlockNumber-1, 0
+		return true
+	}
+	if c.Initialized && (log.BlockNumber < c.BlockIndex ||
+		(log.BlockNumber == c.BlockIndex && uint64(log.Index) <= c.LogIndex)) {
+		return false
+	}
+	c.Initialized, c.BlockIndex, c.LogIndex = true, log.BlockNumber, uint64(log.Index)
+	return true
+}
+
+// AdvanceToBlock moves the cursor to the start of the block if it is a later
+// one, and reports whether it moved. It records that the logs of the blocks
+// before it were processed, even if there were none.
+func (c *LogCursor) AdvanceToBlock(blockNumber uint64) bool {
+	if c.Initialized && blockNumber <= c.BlockIndex {
+		return false
+	}
+	c.Initialized, c.BlockIndex, c.LogIndex = true, blockNumber, 0
+	return true
 }
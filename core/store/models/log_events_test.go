@@ -13,6 +13,7 @@ import (
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -221,6 +222,10 @@ func TestStartRunOrSALogSubscription_ValidateSenders(t *testing.T) {
 				meth.RegisterSubscription("logs", logs)
 				meth.Register("eth_chainId", app.Store.Config.ChainID())
 			})
+			ethMock.Context("Log Broadcaster backfills logs", func(meth *cltest.EthMock) {
+				meth.Register("eth_getBlockByNumber", eth.Block{Number: hexutil.Uint64(1)})
+				meth.Register("eth_getLogs", []eth.Log{})
+			})
 			assert.NoError(t, app.StartAndConnect())
 
 			js := test.job
@@ -519,3 +524,48 @@ func TestIDToIDToHexTopic(t *testing.T) {
 		0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
 	}, models.IDToHexTopic(id))
 }
+
+func TestLogCursor_Advance(t *testing.T) {
+	t.Parallel()
+
+	cursor := models.LogCursor{}
+	assert.True(t, cursor.Advance(eth.Log{BlockNumber: 5, Index: 2}))
+	assert.Equal(t, models.LogCursor{Initialized: true, BlockIndex: 5, LogIndex: 2}, cursor)
+
+	tests := []struct {
+		name       string
+		log        eth.Log
+		wantMoved  bool
+		wantBlock  uint64
+		wantLogIdx uint64
+	}{
+		{"earlier block", eth.Log{BlockNumber: 4, Index: 9}, false, 5, 2},
+		{"same log", eth.Log{BlockNumber: 5, Index: 2}, false, 5, 2},
+		{"later log in block", eth.Log{BlockNumber: 5, Index: 3}, true, 5, 3},
+		{"later block", eth.Log{BlockNumber: 7, Index: 0}, true, 7, 0},
+		{"removed after cursor", eth.Log{BlockNumber: 8, Index: 0, Removed: true}, false, 7, 0},
+		{"removed at cursor", eth.Log{BlockNumber: 7, Index: 0, Removed: true}, true, 6, 0},
+	}
+	for _, test := range tests {
+		moved := cursor.Advance(test.log)
+		assert.Equal(t, test.wantMoved, moved, test.name)
+		assert.Equal(t, test.wantBlock, cursor.BlockIndex, test.name)
+		assert.Equal(t, test.wantLogIdx, cursor.LogIndex, test.name)
+	}
+}
+
+func TestLogCursor_AdvanceToBlock(t *testing.T) {
+	t.Parallel()
+
+	cursor := models.LogCursor{}
+	assert.True(t, cursor.AdvanceToBlock(5))
+	assert.Equal(t, models.LogCursor{Initialized: true, BlockIndex: 5, LogIndex: 0}, cursor)
+
+	assert.False(t, cursor.AdvanceToBlock(4))
+	assert.False(t, cursor.AdvanceToBlock(5))
+	assert.Equal(t, uint64(5), cursor.BlockIndex)
+
+	require.True(t, cursor.Advance(eth.Log{BlockNumber: 6, Index: 3}))
+	assert.True(t, cursor.AdvanceToBlock(7))
+	assert.Equal(t, models.LogCursor{Initialized: true, BlockIndex: 7, LogIndex: 0}, cursor)
+}
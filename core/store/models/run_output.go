@@ -3,14 +3,22 @@ package models
 import (
 	"fmt"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
+
 	"github.com/tidwall/gjson"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // RunOutput represents the result of performing a Task
 type RunOutput struct {
-	data   JSON
-	status RunStatus
-	err    error
+	data     JSON
+	status   RunStatus
+	err      error
+	metadata JSON
+	gasUsed  uint64
+	ethSpent *assets.Eth
+
+	revertReason null.String
 }
 
 // NewRunOutputError returns a new RunOutput with an error
@@ -94,6 +102,50 @@ func (ro RunOutput) Data() JSON {
 	return ro.data
 }
 
+// WithMetadata returns a copy of this RunOutput carrying adapter specific
+// details about how it was obtained, e.g. the HTTP status of a response.
+func (ro RunOutput) WithMetadata(metadata JSON) RunOutput {
+	ro.metadata = metadata
+	return ro
+}
+
+// Metadata returns the adapter specific details attached to this RunOutput
+func (ro RunOutput) Metadata() JSON {
+	return ro.metadata
+}
+
+// WithTxCost returns a copy of this RunOutput carrying the gas used and ETH
+// spent by a transaction the task sent.
+func (ro RunOutput) WithTxCost(gasUsed uint64, ethSpent *assets.Eth) RunOutput {
+	ro.gasUsed = gasUsed
+	ro.ethSpent = ethSpent
+	return ro
+}
+
+// GasUsed returns the gas used by transactions sent to produce this RunOutput
+func (ro RunOutput) GasUsed() uint64 {
+	return ro.gasUsed
+}
+
+// EthSpent returns the ETH paid for transactions sent to produce this
+// RunOutput, or nil if none were sent
+func (ro RunOutput) EthSpent() *assets.Eth {
+	return ro.ethSpent
+}
+
+// WithRevertReason returns a copy of this RunOutput carrying the reason the
+// transaction the task sent reverted with.
+func (ro RunOutput) WithRevertReason(reason string) RunOutput {
+	ro.revertReason = null.StringFrom(reason)
+	return ro
+}
+
+// RevertReason returns the reason the task's transaction reverted with, if
+// it did.
+func (ro RunOutput) RevertReason() null.String {
+	return ro.revertReason
+}
+
 // Status returns the status returned from a task
 func (ro RunOutput) Status() RunStatus {
 	return ro.status
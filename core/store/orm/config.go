@@ -2,6 +2,7 @@ package orm
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -93,6 +95,18 @@ func (c *Config) Validate() error {
 			ethCore.DefaultTxPoolConfig.PriceBump,
 		)
 	}
+	chains, err := c.EthChains()
+	if err != nil {
+		return err
+	}
+	if c.EthHeadHistoryDepth() < 1 {
+		return errors.New("ETH_HEAD_HISTORY_DEPTH must be at least 1")
+	}
+	for _, chain := range chains {
+		if chain.EthHeadHistoryDepth() < 1 {
+			return fmt.Errorf("ETH_CHAINS chain %s: ETH_HEAD_HISTORY_DEPTH must be at least 1", chain.ChainID())
+		}
+	}
 	return nil
 }
 
@@ -139,6 +153,24 @@ func (c Config) AllowOrigins() string {
 	return c.viper.GetString(EnvVarName("AllowOrigins"))
 }
 
+// BalanceMonitorAlertThreshold is the ETH balance below which the balance
+// monitor warns about a key. Zero disables the alerts.
+func (c Config) BalanceMonitorAlertThreshold() *assets.Eth {
+	return c.getWithFallback("BalanceMonitorAlertThreshold", parseEth).(*assets.Eth)
+}
+
+// BalanceMonitorEnabled enables checking the ETH balance of every key on each
+// new head.
+func (c Config) BalanceMonitorEnabled() bool {
+	return c.viper.GetBool(EnvVarName("BalanceMonitorEnabled"))
+}
+
+// BalanceMonitorWebhookURL is the URL the balance monitor POSTs to when a
+// key's balance falls below BALANCE_MONITOR_ALERT_THRESHOLD.
+func (c Config) BalanceMonitorWebhookURL() *url.URL {
+	return c.getWithFallback("BalanceMonitorWebhookURL", parseURL).(*url.URL)
+}
+
 // BridgeResponseURL represents the URL for bridges to send a response to.
 func (c Config) BridgeResponseURL() *url.URL {
 	return c.getWithFallback("BridgeResponseURL", parseURL).(*url.URL)
@@ -247,17 +279,136 @@ func (c Config) EthGasBumpWei() *big.Int {
 	return c.getWithFallback("EthGasBumpWei", parseBigInt).(*big.Int)
 }
 
+// EthHeadHistoryDepth is the number of recent heads kept of each chain, at
+// least 1. Chain reorganizations deeper than the kept heads are handled as if
+// they forked just below the oldest one.
+func (c Config) EthHeadHistoryDepth() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthHeadHistoryDepth"))
+}
+
+// EthLogBackfillBatchSize is the most blocks fetched in one eth_getLogs call
+// when backfilling logs missed while the node was down. Zero fetches the whole
+// range at once.
+func (c Config) EthLogBackfillBatchSize() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthLogBackfillBatchSize"))
+}
+
+// EthPauseEmptyKeys stops keys with no ETH from being chosen to send
+// transactions, so that runs wait for the key to be funded instead of
+// failing to send.
+func (c Config) EthPauseEmptyKeys() bool {
+	return c.viper.GetBool(EnvVarName("EthPauseEmptyKeys"))
+}
+
+// EthPollInterval is how often an Ethereum node at an HTTP ETH_URL is polled
+// for new heads and logs, in place of websocket subscriptions.
+func (c Config) EthPollInterval() models.Duration {
+	return c.getDuration("EthPollInterval")
+}
+
+// EthRPCBatchSize is the most concurrent eth_call, eth_getBalance and
+// eth_getTransactionReceipt calls sent to the Ethereum node in one JSON-RPC
+// batch. Values below 2 disable batching.
+func (c Config) EthRPCBatchSize() int {
+	return c.viper.GetInt(EnvVarName("EthRPCBatchSize"))
+}
+
+// EthSyncCheckInterval is how often the head tracker checks whether the
+// Ethereum node is syncing or behind the chain, pausing runs that talk to it
+// until it has caught up. Zero disables the check.
+func (c Config) EthSyncCheckInterval() models.Duration {
+	return c.getDuration("EthSyncCheckInterval")
+}
+
+// EthKeyMinBalance is the ETH balance below which a key is no longer chosen
+// to send transactions. Zero disables the check.
+func (c Config) EthKeyMinBalance() *assets.Eth {
+	return c.getWithFallback("EthKeyMinBalance", parseEth).(*assets.Eth)
+}
+
+// EthFinalityTag is the block tag runs and transactions wait for to be final.
+// With latest, they wait for MIN_INCOMING_CONFIRMATIONS and
+// MIN_OUTGOING_CONFIRMATIONS blocks instead, as they do on nodes that don't
+// support the tag.
+func (c Config) EthFinalityTag() models.FinalityTag {
+	return c.getWithFallback("EthFinalityTag", parseFinalityTag).(models.FinalityTag)
+}
+
+// EthKeySelectionPolicy is how the key that sends a transaction is chosen,
+// when the job does not pin one.
+func (c Config) EthKeySelectionPolicy() KeySelectionPolicy {
+	return c.getWithFallback("EthKeySelectionPolicy", parseKeySelectionPolicy).(KeySelectionPolicy)
+}
+
 // EthMaxGasPriceWei is the maximum amount in Wei that a transaction will be
 // bumped to before abandoning it and marking it as errored.
 func (c Config) EthMaxGasPriceWei() *big.Int {
 	return c.getWithFallback("EthMaxGasPriceWei", parseBigInt).(*big.Int)
 }
 
+// EthMaxHeadAge is how far behind wall-clock time the timestamp of the
+// latest head may be before the Ethereum node is considered out of sync.
+// Zero disables the comparison, as chains that only mine blocks for new
+// transactions, such as development chains, would otherwise never catch up.
+func (c Config) EthMaxHeadAge() models.Duration {
+	return c.getDuration("EthMaxHeadAge")
+}
+
+// EthNodeCallTimeout is how long an Ethereum node has to respond to a call
+// before it is retried on the next node, when ETH_SECONDARY_URLS are set.
+// Zero disables the timeout.
+func (c Config) EthNodeCallTimeout() models.Duration {
+	return c.getDuration("EthNodeCallTimeout")
+}
+
+// EthNodeMaxErrorRate is the rate of failed calls, between 0 and 1, above
+// which an Ethereum node is unhealthy and failed over from.
+func (c Config) EthNodeMaxErrorRate() float64 {
+	return c.viper.GetFloat64(EnvVarName("EthNodeMaxErrorRate"))
+}
+
+// EthNodeMaxHeadLag is the number of blocks an Ethereum node may be behind
+// the others before it is unhealthy and failed over from.
+func (c Config) EthNodeMaxHeadLag() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthNodeMaxHeadLag"))
+}
+
+// EthNodePollInterval is how often the health of each Ethereum node is
+// checked, when ETH_SECONDARY_URLS are set.
+func (c Config) EthNodePollInterval() models.Duration {
+	return c.getDuration("EthNodePollInterval")
+}
+
+// EthNonceGapRepairThreshold is the number of heads a gap between the nonce
+// the node expects next and the local nonce must persist for before it is
+// filled. Zero disables nonce gap repair.
+func (c Config) EthNonceGapRepairThreshold() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthNonceGapRepairThreshold"))
+}
+
 // EthGasLimitDefault  sets the default gas limit for outgoing transactions.
 func (c Config) EthGasLimitDefault() uint64 {
 	return c.viper.GetUint64(EnvVarName("EthGasLimitDefault"))
 }
 
+// EthGasLimitEstimationEnabled enables setting the gas limit of outgoing
+// transactions from eth_estimateGas, instead of ETH_GAS_LIMIT_DEFAULT.
+func (c Config) EthGasLimitEstimationEnabled() bool {
+	return c.viper.GetBool(EnvVarName("EthGasLimitEstimationEnabled"))
+}
+
+// EthGasLimitMax is the highest gas limit an estimated transaction is sent
+// with.
+func (c Config) EthGasLimitMax() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthGasLimitMax"))
+}
+
+// EthGasLimitMultiplier is multiplied with the gas estimate of a transaction
+// to give it headroom for state changes before it is mined.
+func (c Config) EthGasLimitMultiplier() float64 {
+	return c.viper.GetFloat64(EnvVarName("EthGasLimitMultiplier"))
+}
+
 // EthGasPriceDefault is the starting gas price for every transaction
 func (c Config) EthGasPriceDefault() *big.Int {
 	if c.runtimeStore != nil {
@@ -279,11 +430,144 @@ func (c Config) SetEthGasPriceDefault(value *big.Int) error {
 	return c.runtimeStore.SetConfigValue("EthGasPriceDefault", value)
 }
 
+// EthEIP1559 enables sending EIP-1559 dynamic fee transactions. Only enable
+// it when connected to a chain that has activated the London hard fork.
+func (c Config) EthEIP1559() bool {
+	return c.viper.GetBool(EnvVarName("EthEIP1559"))
+}
+
+// EthGasTipCapDefault is the starting max priority fee per gas, paid to the
+// miner, of every EIP-1559 transaction
+func (c Config) EthGasTipCapDefault() *big.Int {
+	if c.runtimeStore != nil {
+		var value big.Int
+		if err := c.runtimeStore.GetConfigValue("EthGasTipCapDefault", &value); err != nil && errors.Cause(err) != ErrorNotFound {
+			logger.Warnw("Error while trying to fetch EthGasTipCapDefault.", "error", err)
+		} else if err == nil {
+			return &value
+		}
+	}
+	return c.getWithFallback("EthGasTipCapDefault", parseBigInt).(*big.Int)
+}
+
+// SetEthGasTipCapDefault saves a runtime value for the default max priority
+// fee of EIP-1559 transactions
+func (c Config) SetEthGasTipCapDefault(value *big.Int) error {
+	if c.runtimeStore == nil {
+		return errors.New("No runtime store installed")
+	}
+	return c.runtimeStore.SetConfigValue("EthGasTipCapDefault", value)
+}
+
+// EthGasFeeCapDefault is the starting max fee per gas of every EIP-1559
+// transaction. It is kept up to date by the GasUpdater when enabled and
+// otherwise defaults to EthGasPriceDefault
+func (c Config) EthGasFeeCapDefault() *big.Int {
+	if c.runtimeStore != nil {
+		var value big.Int
+		if err := c.runtimeStore.GetConfigValue("EthGasFeeCapDefault", &value); err != nil && errors.Cause(err) != ErrorNotFound {
+			logger.Warnw("Error while trying to fetch EthGasFeeCapDefault.", "error", err)
+		} else if err == nil {
+			return &value
+		}
+	}
+	return c.EthGasPriceDefault()
+}
+
+// SetEthGasFeeCapDefault saves a runtime value for the default max fee of
+// EIP-1559 transactions
+func (c Config) SetEthGasFeeCapDefault(value *big.Int) error {
+	if c.runtimeStore == nil {
+		return errors.New("No runtime store installed")
+	}
+	return c.runtimeStore.SetConfigValue("EthGasFeeCapDefault", value)
+}
+
 // EthereumURL represents the URL of the Ethereum node to connect Chainlink to.
 func (c Config) EthereumURL() string {
 	return c.viper.GetString(EnvVarName("EthereumURL"))
 }
 
+// EthereumSecondaryURLs are the URLs of Ethereum nodes to fail over to when
+// the node at EthereumURL is unhealthy, separated by commas.
+func (c Config) EthereumSecondaryURLs() []string {
+	var urls []string
+	for _, url := range strings.Split(c.viper.GetString(EnvVarName("EthereumSecondaryURLs")), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// EthChains returns the config of each additional EVM chain in ETH_CHAINS, a
+// JSON array with an object of config values for each chain, such as
+// ETH_CHAIN_ID, ETH_URL, LINK_CONTRACT_ADDRESS and ETH_GAS_PRICE_DEFAULT.
+// ETH_CHAIN_ID and ETH_URL are required, other values are those of the
+// primary chain unless set.
+func (c Config) EthChains() ([]*Config, error) {
+	str := c.viper.GetString(EnvVarName("EthChains"))
+	if strings.TrimSpace(str) == "" {
+		return nil, nil
+	}
+	var chainValues []map[string]string
+	if err := json.Unmarshal([]byte(str), &chainValues); err != nil {
+		return nil, errors.Wrap(err, "ETH_CHAINS must be a JSON array of objects")
+	}
+
+	chainIDs := map[string]bool{c.ChainID().String(): true}
+	chains := make([]*Config, len(chainValues))
+	for i, values := range chainValues {
+		chain, err := c.forChain(values)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ETH_CHAINS chain %d", i+1)
+		}
+		chainID := chain.ChainID().String()
+		if chainIDs[chainID] {
+			return nil, fmt.Errorf("ETH_CHAINS chain %d: chain ID %s is already in use", i+1, chainID)
+		}
+		chainIDs[chainID] = true
+		chains[i] = chain
+	}
+	return chains, nil
+}
+
+// forChain returns a copy of the config with the values set. The copy has no
+// runtime store, so values set at runtime, such as the gas price set by the
+// gas updater, only apply to the primary chain.
+func (c Config) forChain(values map[string]string) (*Config, error) {
+	if values["ETH_CHAIN_ID"] == "" || values["ETH_URL"] == "" {
+		return nil, errors.New("ETH_CHAIN_ID and ETH_URL are required")
+	}
+
+	v := viper.New()
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	for index := 0; index < schemaT.NumField(); index++ {
+		name := schemaT.Field(index).Tag.Get("env")
+		v.Set(name, c.viper.Get(name))
+	}
+	// Secondary nodes and chains are those of the primary chain
+	v.Set(EnvVarName("EthereumSecondaryURLs"), "")
+	v.Set(EnvVarName("EthChains"), "")
+
+	for name, value := range values {
+		if name == EnvVarName("EthChains") || !isConfigEnvVar(name) {
+			return nil, fmt.Errorf("%s cannot be set for a chain", name)
+		}
+		v.Set(name, value)
+	}
+	if _, err := parseBigInt(v.GetString(EnvVarName("ChainID"))); err != nil {
+		return nil, errors.Wrap(err, "invalid ETH_CHAIN_ID")
+	}
+
+	return &Config{
+		viper:           v,
+		SecretGenerator: c.SecretGenerator,
+		Dialect:         c.Dialect,
+		AdvisoryLockID:  c.AdvisoryLockID,
+	}, nil
+}
+
 // EthereumDisabled shows whether Ethereum interactions are supported.
 func (c Config) EthereumDisabled() bool {
 	return c.viper.GetBool(EnvVarName("EthereumDisabled"))
@@ -319,6 +603,24 @@ func (c Config) GasUpdaterEnabled() bool {
 	return c.viper.GetBool(EnvVarName("GasUpdaterEnabled"))
 }
 
+// JobMaxInFlightRuns is the maximum number of unfinished runs a single job
+// may have. Further run requests for the job are rejected. Zero means no limit.
+func (c Config) JobMaxInFlightRuns() uint32 {
+	return c.viper.GetUint32(EnvVarName("JobMaxInFlightRuns"))
+}
+
+// JobRunRateLimit is the maximum number of runs a single job may start per
+// JobRunRateLimitInterval. Further run requests for the job are rejected.
+// Zero means no limit.
+func (c Config) JobRunRateLimit() uint32 {
+	return c.viper.GetUint32(EnvVarName("JobRunRateLimit"))
+}
+
+// JobRunRateLimitInterval is the window over which JobRunRateLimit applies.
+func (c Config) JobRunRateLimitInterval() models.Duration {
+	return c.getDuration("JobRunRateLimitInterval")
+}
+
 // JSONConsole enables the JSON console.
 func (c Config) JSONConsole() bool {
 	return c.viper.GetBool(EnvVarName("JSONConsole"))
@@ -329,6 +631,12 @@ func (c Config) LinkContractAddress() string {
 	return c.viper.GetString(EnvVarName("LinkContractAddress"))
 }
 
+// LinkPerEth is the number of LINK one ETH is worth, used to weigh the gas a
+// job spends against the LINK it earns. Zero disables profitability reporting.
+func (c Config) LinkPerEth() float64 {
+	return c.viper.GetFloat64(EnvVarName("LinkPerEth"))
+}
+
 // ExplorerURL returns the websocket URL for this node to push stats to, or nil.
 func (c Config) ExplorerURL() *url.URL {
 	rval := c.getWithFallback("ExplorerURL", parseURL)
@@ -426,6 +734,46 @@ func (c Config) RootDir() string {
 	return c.getWithFallback("RootDir", parseHomeDir).(string)
 }
 
+// RunReaperInterval is how often the run reaper applies the run retention
+// policy.
+func (c Config) RunReaperInterval() models.Duration {
+	return c.getDuration("RunReaperInterval")
+}
+
+// RunRequestDedupWindow is how long an idempotency key supplied with a run
+// request is remembered for its job.
+func (c Config) RunRequestDedupWindow() models.Duration {
+	return c.getDuration("RunRequestDedupWindow")
+}
+
+// RunRetentionBatchSize is the maximum number of job runs deleted per
+// transaction when pruning, so that no single delete holds locks for long.
+func (c Config) RunRetentionBatchSize() uint32 {
+	return c.viper.GetUint32(EnvVarName("RunRetentionBatchSize"))
+}
+
+// RunRetentionMaxAge is how long finished job runs are kept after their last
+// update. Zero disables pruning by age.
+func (c Config) RunRetentionMaxAge() models.Duration {
+	return c.getDuration("RunRetentionMaxAge")
+}
+
+// RunRetentionMaxRunsPerJob is the number of most recent finished job runs
+// kept for each job. Zero disables pruning by count.
+func (c Config) RunRetentionMaxRunsPerJob() uint32 {
+	return c.viper.GetUint32(EnvVarName("RunRetentionMaxRunsPerJob"))
+}
+
+// RunRetentionStatuses are the job run statuses eligible for pruning.
+func (c Config) RunRetentionStatuses() models.RunStatusCollection {
+	var statuses models.RunStatusCollection
+	str := strings.ReplaceAll(c.viper.GetString(EnvVarName("RunRetentionStatuses")), " ", "")
+	if err := statuses.Scan(str); err != nil {
+		panic(errors.Wrap(err, "bad value for config value RunRetentionStatuses"))
+	}
+	return statuses
+}
+
 // SecureCookies allows toggling of the secure cookies HTTP flag
 func (c Config) SecureCookies() bool {
 	return c.viper.GetBool(EnvVarName("SecureCookies"))
@@ -568,6 +916,16 @@ func (f filePersistedSecretGenerator) Generate(c Config) ([]byte, error) {
 	return key, ioutil.WriteFile(sessionPath, []byte(str), readWritePerms)
 }
 
+func isConfigEnvVar(name string) bool {
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	for index := 0; index < schemaT.NumField(); index++ {
+		if schemaT.Field(index).Tag.Get("env") == name {
+			return true
+		}
+	}
+	return false
+}
+
 func parseAddress(str string) (interface{}, error) {
 	if str == "" {
 		return nil, nil
@@ -589,6 +947,28 @@ func parseLink(str string) (interface{}, error) {
 	return i, nil
 }
 
+func parseEth(str string) (interface{}, error) {
+	i, ok := new(assets.Eth).SetString(str, 10)
+	if !ok {
+		return i, fmt.Errorf("Unable to parse '%v' into *assets.Eth(base 10)", str)
+	}
+	return i, nil
+}
+
+func parseKeySelectionPolicy(str string) (interface{}, error) {
+	policy := KeySelectionPolicy(str)
+	switch policy {
+	case KeySelectionRoundRobin, KeySelectionLeastPending, KeySelectionHighestBalance:
+		return policy, nil
+	}
+	return nil, fmt.Errorf("Unknown key selection policy '%s', must be one of %s, %s or %s",
+		str, KeySelectionRoundRobin, KeySelectionLeastPending, KeySelectionHighestBalance)
+}
+
+func parseFinalityTag(str string) (interface{}, error) {
+	return models.ParseFinalityTag(str)
+}
+
 func parseLogLevel(str string) (interface{}, error) {
 	var lvl LogLevel
 	err := lvl.Set(str)
@@ -620,6 +1000,19 @@ func parseHomeDir(str string) (interface{}, error) {
 	return filepath.ToSlash(exp), nil
 }
 
+// KeySelectionPolicy determines which key sends an outgoing transaction.
+type KeySelectionPolicy string
+
+const (
+	// KeySelectionRoundRobin cycles through the keys in turn.
+	KeySelectionRoundRobin KeySelectionPolicy = "round-robin"
+	// KeySelectionLeastPending picks the key with the fewest unconfirmed
+	// transactions.
+	KeySelectionLeastPending KeySelectionPolicy = "least-pending"
+	// KeySelectionHighestBalance picks the key with the most ETH.
+	KeySelectionHighestBalance KeySelectionPolicy = "highest-balance"
+)
+
 // LogLevel determines the verbosity of the events to be logged.
 type LogLevel struct {
 	zapcore.Level
@@ -15,6 +15,9 @@ import (
 // ConfigReader represents just the read side of the config
 type ConfigReader interface {
 	AllowOrigins() string
+	BalanceMonitorAlertThreshold() *assets.Eth
+	BalanceMonitorEnabled() bool
+	BalanceMonitorWebhookURL() *url.URL
 	BridgeResponseURL() *url.URL
 	ChainID() *big.Int
 	ClientNodeURL() string
@@ -29,19 +32,48 @@ type ConfigReader interface {
 	MaximumServiceDuration() models.Duration
 	MinimumServiceDuration() models.Duration
 	EnableExperimentalAdapters() bool
+	EthEIP1559() bool
+	EthFinalityTag() models.FinalityTag
 	EthGasBumpPercent() uint16
 	EthGasBumpThreshold() uint64
 	EthGasBumpWei() *big.Int
 	EthGasLimitDefault() uint64
+	EthGasLimitEstimationEnabled() bool
+	EthGasLimitMax() uint64
+	EthGasLimitMultiplier() float64
 	EthGasPriceDefault() *big.Int
+	EthHeadHistoryDepth() uint64
+	EthKeyMinBalance() *assets.Eth
+	EthKeySelectionPolicy() KeySelectionPolicy
+	EthLogBackfillBatchSize() uint64
 	EthMaxGasPriceWei() *big.Int
+	EthMaxHeadAge() models.Duration
+	EthNodeCallTimeout() models.Duration
+	EthNodeMaxErrorRate() float64
+	EthNodeMaxHeadLag() uint64
+	EthNodePollInterval() models.Duration
+	EthNonceGapRepairThreshold() uint64
+	EthPauseEmptyKeys() bool
+	EthPollInterval() models.Duration
+	EthRPCBatchSize() int
+	EthSyncCheckInterval() models.Duration
 	SetEthGasPriceDefault(value *big.Int) error
+	EthGasTipCapDefault() *big.Int
+	SetEthGasTipCapDefault(value *big.Int) error
+	EthGasFeeCapDefault() *big.Int
+	SetEthGasFeeCapDefault(value *big.Int) error
 	EthereumURL() string
+	EthereumSecondaryURLs() []string
+	EthChains() ([]*Config, error)
 	GasUpdaterBlockDelay() uint16
 	GasUpdaterBlockHistorySize() uint16
 	GasUpdaterTransactionPercentile() uint16
+	JobMaxInFlightRuns() uint32
+	JobRunRateLimit() uint32
+	JobRunRateLimitInterval() models.Duration
 	JSONConsole() bool
 	LinkContractAddress() string
+	LinkPerEth() float64
 	ExplorerURL() *url.URL
 	ExplorerAccessKey() string
 	ExplorerSecret() string
@@ -57,6 +89,12 @@ type ConfigReader interface {
 	Port() uint16
 	ReaperExpiration() models.Duration
 	RootDir() string
+	RunReaperInterval() models.Duration
+	RunRequestDedupWindow() models.Duration
+	RunRetentionBatchSize() uint32
+	RunRetentionMaxAge() models.Duration
+	RunRetentionMaxRunsPerJob() uint32
+	RunRetentionStatuses() models.RunStatusCollection
 	SecureCookies() bool
 	SessionTimeout() models.Duration
 	TLSCertPath() string
@@ -60,6 +60,59 @@ func TestConfig_sessionOptions(t *testing.T) {
 	require.True(t, opts.Secure)
 }
 
+func TestConfig_EthChains(t *testing.T) {
+	config := NewConfig()
+	config.Set("ETH_GAS_PRICE_DEFAULT", "30000000000")
+	config.Set("ETH_SECONDARY_URLS", "ws://backup:8546")
+
+	chains, err := config.EthChains()
+	require.NoError(t, err)
+	assert.Empty(t, chains)
+
+	config.Set("ETH_CHAINS", `[{"ETH_CHAIN_ID": "100", "ETH_URL": "wss://xdai:8546", "ETH_GAS_PRICE_DEFAULT": "1000000000"}]`)
+	chains, err = config.EthChains()
+	require.NoError(t, err)
+	require.Len(t, chains, 1)
+	assert.Equal(t, big.NewInt(100), chains[0].ChainID())
+	assert.Equal(t, "wss://xdai:8546", chains[0].EthereumURL())
+	assert.Equal(t, big.NewInt(1000000000), chains[0].EthGasPriceDefault())
+	assert.Empty(t, chains[0].EthereumSecondaryURLs(), "does not inherit the primary chain's nodes")
+	assert.Equal(t, config.EthGasLimitDefault(), chains[0].EthGasLimitDefault())
+	assert.Equal(t, big.NewInt(1), config.ChainID(), "leaves the primary chain unchanged")
+
+	tests := []struct {
+		name   string
+		chains string
+	}{
+		{"not JSON", `xdai`},
+		{"missing URL", `[{"ETH_CHAIN_ID": "100"}]`},
+		{"invalid chain ID", `[{"ETH_CHAIN_ID": "xdai", "ETH_URL": "wss://xdai:8546"}]`},
+		{"primary chain ID", `[{"ETH_CHAIN_ID": "1", "ETH_URL": "wss://xdai:8546"}]`},
+		{"unknown config", `[{"ETH_CHAIN_ID": "100", "ETH_URL": "wss://xdai:8546", "NOT_CONFIG": "1"}]`},
+		{"duplicate chain ID", `[{"ETH_CHAIN_ID": "100", "ETH_URL": "wss://a:8546"}, {"ETH_CHAIN_ID": "100", "ETH_URL": "wss://b:8546"}]`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config.Set("ETH_CHAINS", test.chains)
+			_, err := config.EthChains()
+			assert.Error(t, err)
+			assert.Error(t, config.Validate())
+		})
+	}
+}
+
+func TestConfig_Validate_EthHeadHistoryDepth(t *testing.T) {
+	config := NewConfig()
+	require.NoError(t, config.Validate())
+
+	config.Set("ETH_HEAD_HISTORY_DEPTH", 0)
+	assert.EqualError(t, config.Validate(), "ETH_HEAD_HISTORY_DEPTH must be at least 1")
+
+	config.Set("ETH_HEAD_HISTORY_DEPTH", 100)
+	config.Set("ETH_CHAINS", `[{"ETH_CHAIN_ID": "100", "ETH_URL": "wss://xdai:8546", "ETH_HEAD_HISTORY_DEPTH": "0"}]`)
+	assert.EqualError(t, config.Validate(), "ETH_CHAINS chain 100: ETH_HEAD_HISTORY_DEPTH must be at least 1")
+}
+
 func TestConfig_readFromFile(t *testing.T) {
 	v := viper.New()
 	v.Set("ROOT", "../../../tools/clroot/")
@@ -132,6 +185,20 @@ func TestStore_levelParser(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestStore_keySelectionPolicyParser(t *testing.T) {
+	val, err := parseKeySelectionPolicy("least-pending")
+	assert.NoError(t, err)
+	assert.Equal(t, KeySelectionLeastPending, val)
+
+	_, err = parseKeySelectionPolicy("random")
+	assert.Error(t, err)
+
+	config := NewConfig()
+	assert.Equal(t, KeySelectionRoundRobin, config.EthKeySelectionPolicy())
+	config.Set("ETH_KEY_SELECTION_POLICY", "random")
+	assert.Equal(t, KeySelectionRoundRobin, config.EthKeySelectionPolicy())
+}
+
 func TestStore_urlParser(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -26,6 +26,7 @@ import (
 	_ "github.com/jinzhu/gorm/dialects/postgres" // http://doc.gorm.io/database.html#connecting-to-a-database
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // BatchSize is the safe number of records to cache during Batch calls for
@@ -245,6 +246,36 @@ func (orm *ORM) FindJob(id *models.ID) (models.JobSpec, error) {
 	return job, orm.preloadJobs().First(&job, "id = ?", id).Error
 }
 
+// FindJobChainID returns the ID of the chain the job runs on, nil for the
+// primary chain.
+func (orm *ORM) FindJobChainID(id *models.ID) (*utils.Big, error) {
+	orm.MustEnsureAdvisoryLock()
+	var job models.JobSpec
+	err := orm.db.Unscoped().Select("chain_id").First(&job, "id = ?", id).Error
+	return job.ChainID, err
+}
+
+// FindJobFinalityTag returns the block tag the job's runs wait for to be
+// final, empty if the job doesn't set one.
+func (orm *ORM) FindJobFinalityTag(id *models.ID) (models.FinalityTag, error) {
+	orm.MustEnsureAdvisoryLock()
+	var job models.JobSpec
+	err := orm.db.Unscoped().Select("finality_tag").First(&job, "id = ?", id).Error
+	return job.FinalityTag, err
+}
+
+// FindJobRunFinalityTag returns the block tag the job of the run waits for to
+// be final, empty if the job doesn't set one.
+func (orm *ORM) FindJobRunFinalityTag(runID *models.ID) (models.FinalityTag, error) {
+	orm.MustEnsureAdvisoryLock()
+	var job models.JobSpec
+	err := orm.db.Unscoped().
+		Select("job_specs.finality_tag").
+		Joins("JOIN job_runs ON job_runs.job_spec_id = job_specs.id").
+		First(&job, "job_runs.id = ?", runID).Error
+	return job.FinalityTag, err
+}
+
 // FindInitiator returns the single initiator defined by the passed ID.
 func (orm *ORM) FindInitiator(ID uint32) (models.Initiator, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -389,6 +420,36 @@ func (orm *ORM) LinkEarnedFor(spec *models.JobSpec) (*assets.Link, error) {
 	return earned, nil
 }
 
+// RunCosts totals what a job's runs earned in LINK and spent in gas.
+type RunCosts struct {
+	Runs       int
+	LinkEarned *assets.Link
+	GasUsed    uint64
+	EthSpent   *assets.Eth
+}
+
+// RunCostsFor totals the earnings and transaction costs of the job's runs
+// created at or after the given time. Only completed runs count as earning,
+// while every run counts towards the gas spent.
+func (orm *ORM) RunCostsFor(spec *models.JobSpec, since time.Time) (RunCosts, error) {
+	orm.MustEnsureAdvisoryLock()
+	costs := RunCosts{LinkEarned: assets.NewLink(0), EthSpent: assets.NewEth(0)}
+	err := orm.db.Raw(`
+		SELECT
+			COUNT(*),
+			COALESCE(SUM(payment) FILTER (WHERE status = ? AND finished_at IS NOT NULL), 0),
+			COALESCE(SUM(gas_used), 0),
+			COALESCE(SUM(eth_spent), 0)
+		FROM job_runs
+		WHERE job_spec_id = ? AND created_at >= ? AND deleted_at IS NULL`,
+		models.RunStatusCompleted, spec.ID, since,
+	).Row().Scan(&costs.Runs, costs.LinkEarned, &costs.GasUsed, costs.EthSpent)
+	if err != nil {
+		return costs, errors.Wrap(err, "error totalling run costs from job_runs")
+	}
+	return costs, nil
+}
+
 // CreateExternalInitiator inserts a new external initiator
 func (orm *ORM) CreateExternalInitiator(externalInitiator *models.ExternalInitiator) error {
 	orm.MustEnsureAdvisoryLock()
@@ -503,6 +564,60 @@ func (orm *ORM) JobRunsCountFor(jobSpecID *models.ID) (int, error) {
 	return count, err
 }
 
+// JobRunsCreatedSinceCountFor returns the number of runs of the job created
+// at or after the given time, not counting errored runs.
+func (orm *ORM) JobRunsCreatedSinceCountFor(jobSpecID *models.ID, since time.Time) (int, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	err := orm.db.
+		Model(&models.JobRun{}).
+		Where("job_spec_id = ? AND created_at >= ? AND status <> ?", jobSpecID, since, models.RunStatusErrored).
+		Count(&count).Error
+	return count, err
+}
+
+// UnfinishedJobRunsCountFor returns the number of runs of the job that have
+// not yet completed, errored or been cancelled.
+func (orm *ORM) UnfinishedJobRunsCountFor(jobSpecID *models.ID) (int, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	err := orm.db.
+		Model(&models.JobRun{}).
+		Where("job_spec_id = ? AND status NOT IN (?)", jobSpecID, []models.RunStatus{
+			models.RunStatusCompleted, models.RunStatusErrored, models.RunStatusCancelled,
+		}).
+		Count(&count).Error
+	return count, err
+}
+
+// FindJobRunByIdempotencyKey returns the most recent run of the job created
+// at or after the given time whose run request carried the idempotency key.
+func (orm *ORM) FindJobRunByIdempotencyKey(jobSpecID *models.ID, key string, since time.Time) (models.JobRun, error) {
+	orm.MustEnsureAdvisoryLock()
+	var jr models.JobRun
+	err := orm.preloadJobRuns().
+		Select("job_runs.*").
+		Joins("JOIN run_requests ON run_requests.id = job_runs.run_request_id").
+		Where("job_runs.job_spec_id = ? AND run_requests.idempotency_key = ? AND job_runs.created_at >= ?", jobSpecID, key, since).
+		Order("job_runs.created_at desc").
+		First(&jr).Error
+	return jr, err
+}
+
+// JobRunsForLog returns the runs of the job created from a log of the
+// transaction in the block.
+func (orm *ORM) JobRunsForLog(jobSpecID *models.ID, txHash, blockHash common.Hash) ([]models.JobRun, error) {
+	orm.MustEnsureAdvisoryLock()
+	var runs []models.JobRun
+	err := orm.preloadJobRuns().
+		Select("job_runs.*").
+		Joins("JOIN run_requests ON run_requests.id = job_runs.run_request_id").
+		Where("job_runs.job_spec_id = ? AND run_requests.tx_hash = ? AND run_requests.block_hash = ?", jobSpecID, txHash, blockHash).
+		Order("job_runs.created_at asc").
+		Find(&runs).Error
+	return runs, err
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (orm *ORM) Sessions(offset, limit int) ([]models.Session, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -591,9 +706,26 @@ func (orm *ORM) CreateServiceAgreement(sa *models.ServiceAgreement) error {
 // UnscopedJobRunsWithStatus passes all JobRuns to a callback, one by one,
 // including those that were soft deleted.
 func (orm *ORM) UnscopedJobRunsWithStatus(cb func(*models.JobRun), statuses ...models.RunStatus) error {
+	return orm.unscopedJobRunsWithStatus(orm.db.Unscoped(), cb, statuses...)
+}
+
+// UnscopedJobRunsOnChainWithStatus passes the JobRuns of jobs on the chain to
+// a callback, one by one, including those that were soft deleted. A nil
+// chain ID is the primary chain.
+func (orm *ORM) UnscopedJobRunsOnChainWithStatus(cb func(*models.JobRun), chainID *utils.Big, statuses ...models.RunStatus) error {
+	scope := orm.db.Unscoped()
+	if chainID == nil {
+		scope = scope.Where("job_spec_id IN (SELECT id FROM job_specs WHERE chain_id IS NULL)")
+	} else {
+		scope = scope.Where("job_spec_id IN (SELECT id FROM job_specs WHERE chain_id = ?)", chainID)
+	}
+	return orm.unscopedJobRunsWithStatus(scope, cb, statuses...)
+}
+
+func (orm *ORM) unscopedJobRunsWithStatus(scope *gorm.DB, cb func(*models.JobRun), statuses ...models.RunStatus) error {
 	orm.MustEnsureAdvisoryLock()
 	var runIDs []string
-	err := orm.db.Unscoped().
+	err := scope.
 		Table("job_runs").
 		Where("status IN (?)", statuses).
 		Order("created_at asc").
@@ -666,19 +798,35 @@ func (orm *ORM) CreateTx(tx *models.Tx) (*models.Tx, error) {
 
 // AddTxAttempt attaches a new attempt to a Tx, after the attempt has been sent to the chain
 func (orm *ORM) AddTxAttempt(tx *models.Tx, newTxAttempt *models.Tx) (*models.TxAttempt, error) {
+	return orm.addTxAttempt(tx, newTxAttempt, false)
+}
+
+// AddCancellationTxAttempt adds an attempt cancelling the transaction, as
+// AddTxAttempt does, leaving the recipient, data, value and gas limit of the
+// transaction as they were.
+func (orm *ORM) AddCancellationTxAttempt(tx *models.Tx, newTxAttempt *models.Tx) (*models.TxAttempt, error) {
+	return orm.addTxAttempt(tx, newTxAttempt, true)
+}
+
+func (orm *ORM) addTxAttempt(tx *models.Tx, newTxAttempt *models.Tx, cancellation bool) (*models.TxAttempt, error) {
 	orm.MustEnsureAdvisoryLock()
 
 	tx.From = newTxAttempt.From
 	tx.Nonce = newTxAttempt.Nonce
 	tx.GasPrice = newTxAttempt.GasPrice
+	tx.GasTipCap = newTxAttempt.GasTipCap
+	tx.GasFeeCap = newTxAttempt.GasFeeCap
 	tx.Hash = newTxAttempt.Hash
 	tx.SentAt = newTxAttempt.SentAt
 	tx.SignedRawTx = newTxAttempt.SignedRawTx
 	txAttempt := &models.TxAttempt{
-		Hash:        newTxAttempt.Hash,
-		GasPrice:    newTxAttempt.GasPrice,
-		SentAt:      newTxAttempt.SentAt,
-		SignedRawTx: newTxAttempt.SignedRawTx,
+		Hash:         newTxAttempt.Hash,
+		GasPrice:     newTxAttempt.GasPrice,
+		GasTipCap:    newTxAttempt.GasTipCap,
+		GasFeeCap:    newTxAttempt.GasFeeCap,
+		SentAt:       newTxAttempt.SentAt,
+		SignedRawTx:  newTxAttempt.SignedRawTx,
+		Cancellation: cancellation,
 	}
 	tx.Attempts = append(tx.Attempts, txAttempt)
 
@@ -700,6 +848,44 @@ func (orm *ORM) MarkTxSafe(tx *models.Tx, txAttempt *models.TxAttempt) error {
 	return orm.db.Save(tx).Error
 }
 
+// UnconfirmTxsAfter marks the transactions of the chain, the primary chain if
+// the chain ID is nil, whose safe attempt was mined after the block number as
+// unconfirmed again, as a chain reorganization orphaned their blocks. It
+// returns them with their attempts, to be checked again.
+func (orm *ORM) UnconfirmTxsAfter(chainID *utils.Big, blockNumber int64) ([]models.Tx, error) {
+	orm.MustEnsureAdvisoryLock()
+	onChain, args := "txes.chain_id IS NULL", []interface{}{blockNumber}
+	if chainID != nil {
+		onChain, args = "txes.chain_id = ?", []interface{}{chainID, blockNumber}
+	}
+
+	var txs []models.Tx
+	err := orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		var ids []uint64
+		err := dbtx.
+			Table("txes").
+			Joins("inner join tx_attempts on tx_attempts.tx_id = txes.id").
+			Where(onChain+" AND tx_attempts.receipt_block_number > ?", args...).
+			Pluck("DISTINCT txes.id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		err = dbtx.Model(&models.TxAttempt{}).
+			Where("tx_id IN (?)", ids).
+			Updates(map[string]interface{}{"confirmed": false, "receipt_block_number": nil}).Error
+		if err != nil {
+			return errors.Wrap(err, "UnconfirmTxsAfter unconfirming attempts")
+		}
+		err = dbtx.Model(&models.Tx{}).Where("id IN (?)", ids).UpdateColumn("confirmed", false).Error
+		if err != nil {
+			return errors.Wrap(err, "UnconfirmTxsAfter unconfirming txes")
+		}
+		return preloadAttempts(dbtx).Where("id IN (?)", ids).Find(&txs).Error
+	})
+	return txs, err
+}
+
 func preloadAttempts(dbtx *gorm.DB) *gorm.DB {
 	return dbtx.
 		Preload("Attempts", func(db *gorm.DB) *gorm.DB {
@@ -715,14 +901,62 @@ func (orm *ORM) FindTx(ID uint64) (*models.Tx, error) {
 	return tx, err
 }
 
-// FindAllTxsInNonceRange returns an array of transactions matching the inclusive range between beginningNonce and endingNonce
-func (orm *ORM) FindAllTxsInNonceRange(beginningNonce uint, endingNonce uint) ([]models.Tx, error) {
+// FindAllTxsInNonceRange returns an array of transactions of the chain, the
+// primary chain if the chain ID is nil, matching the inclusive range between
+// beginningNonce and endingNonce
+func (orm *ORM) FindAllTxsInNonceRange(chainID *utils.Big, beginningNonce uint, endingNonce uint) ([]models.Tx, error) {
 	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("chain_id = ?", chainID)
+	}
 	var txs []models.Tx
-	err := orm.db.Order("nonce ASC, sent_at ASC").Where(`nonce BETWEEN ? AND ?`, beginningNonce, endingNonce).Find(&txs).Error
+	err := scope.Order("nonce ASC, sent_at ASC").Where(`nonce BETWEEN ? AND ?`, beginningNonce, endingNonce).Find(&txs).Error
 	return txs, err
 }
 
+// FindTxByNonce returns the transaction sent by `from` with the given nonce
+// on the chain, the primary chain if the chain ID is nil.
+func (orm *ORM) FindTxByNonce(chainID *utils.Big, from common.Address, nonce uint64) (*models.Tx, error) {
+	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("chain_id = ?", chainID)
+	}
+	tx := &models.Tx{}
+	err := preloadAttempts(scope).First(tx, `"from" = ? AND nonce = ?`, from, nonce).Error
+	return tx, err
+}
+
+// UnconfirmedTxCounts returns the number of unconfirmed transactions sent
+// on the chain, the primary chain if the chain ID is nil, by each address
+// that has any.
+func (orm *ORM) UnconfirmedTxCounts(chainID *utils.Big) (map[common.Address]int, error) {
+	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("chain_id = ?", chainID)
+	}
+	var rows []struct {
+		From  common.Address
+		Count int
+	}
+	err := scope.
+		Model(&models.Tx{}).
+		Select(`"from", count(*) AS count`).
+		Where("confirmed = ?", false).
+		Group(`"from"`).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[common.Address]int, len(rows))
+	for _, row := range rows {
+		counts[row.From] = row.Count
+	}
+	return counts, nil
+}
+
 // FindTxsBySenderAndRecipient returns an array of transactions sent by `sender` to `recipient`
 func (orm *ORM) FindTxsBySenderAndRecipient(sender, recipient common.Address, offset, limit uint) ([]models.Tx, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -761,10 +995,15 @@ func (orm *ORM) FindTxAttempt(hash common.Hash) (*models.TxAttempt, error) {
 }
 
 // GetLastNonce retrieves the last known nonce in the database for an account
-func (orm *ORM) GetLastNonce(address common.Address) (uint64, error) {
+// on the chain, the primary chain if the chain ID is nil
+func (orm *ORM) GetLastNonce(chainID *utils.Big, address common.Address) (uint64, error) {
 	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("chain_id = ?", chainID)
+	}
 	var transaction models.Tx
-	rval := orm.db.Order("nonce desc").Where(`"from" = ?`, address).First(&transaction)
+	rval := scope.Order("nonce desc").Where(`"from" = ?`, address).First(&transaction)
 	return transaction.Nonce, ignoreRecordNotFound(rval)
 }
 
@@ -966,12 +1205,18 @@ func (orm *ORM) TxAttempts(offset, limit int) ([]models.TxAttempt, int, error) {
 	return attempts, count, err
 }
 
-// UnconfirmedTxAttempts returns all TxAttempts for which the associated Tx is still unconfirmed.
-func (orm *ORM) UnconfirmedTxAttempts() ([]models.TxAttempt, error) {
+// UnconfirmedTxAttempts returns all TxAttempts for which the associated Tx,
+// sent on the chain, the primary chain if the chain ID is nil, is still
+// unconfirmed.
+func (orm *ORM) UnconfirmedTxAttempts(chainID *utils.Big) ([]models.TxAttempt, error) {
 	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("txes.chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("txes.chain_id = ?", chainID)
+	}
 	var items []models.TxAttempt
 
-	err := orm.db.
+	err := scope.
 		Preload("Tx").
 		Joins("inner join txes on txes.id = tx_attempts.tx_id").
 		Where("txes.confirmed = ?", false).
@@ -1047,6 +1292,13 @@ func (orm *ORM) SaveTx(tx *models.Tx) error {
 	return orm.db.Save(tx).Error
 }
 
+// SetTxRevertReason saves the reason a mined transaction reverted with.
+func (orm *ORM) SetTxRevertReason(tx *models.Tx, reason string) error {
+	orm.MustEnsureAdvisoryLock()
+	tx.RevertReason = null.StringFrom(reason)
+	return orm.db.Model(tx).UpdateColumn("revert_reason", tx.RevertReason).Error
+}
+
 // CreateBridgeType saves the bridge type.
 func (orm *ORM) CreateBridgeType(bt *models.BridgeType) error {
 	orm.MustEnsureAdvisoryLock()
@@ -1092,17 +1344,90 @@ func (orm *ORM) FirstHead() (*models.Head, error) {
 	return number, err
 }
 
-// LastHead returns the most recently persisted head entry.
+// LastHead returns the most recently persisted head entry of the primary
+// chain.
 func (orm *ORM) LastHead() (*models.Head, error) {
+	return orm.LastHeadOnChain(nil)
+}
+
+// LastHeadOnChain returns the most recently persisted head entry of the
+// chain, the primary chain if the chain ID is nil.
+func (orm *ORM) LastHeadOnChain(chainID *utils.Big) (*models.Head, error) {
 	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("chain_id = ?", chainID)
+	}
 	number := &models.Head{}
-	err := orm.db.Order("number desc").First(number).Error
+	err := scope.Order("number desc").First(number).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
 	return number, err
 }
 
+// HeadsOnChain returns the persisted heads of the chain, the primary chain if
+// the chain ID is nil, newest first.
+func (orm *ORM) HeadsOnChain(chainID *utils.Big) ([]models.Head, error) {
+	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("chain_id = ?", chainID)
+	}
+	var heads []models.Head
+	return heads, scope.Order("number desc").Find(&heads).Error
+}
+
+// DeleteHeadsOnChainAfter deletes the persisted heads of the chain above the
+// block number, after they were orphaned by a chain reorganization.
+func (orm *ORM) DeleteHeadsOnChainAfter(chainID *utils.Big, number int64) error {
+	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("chain_id = ?", chainID)
+	}
+	return scope.Where("number > ?", number).Delete(models.Head{}).Error
+}
+
+// Heads returns a page of the persisted heads of the chain, the primary chain
+// if the chain ID is nil, newest first, and the number of heads it has.
+func (orm *ORM) Heads(chainID *utils.Big, offset, limit int) ([]models.Head, int, error) {
+	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id IS NULL")
+	if chainID != nil {
+		scope = orm.db.Where("chain_id = ?", chainID)
+	}
+	var count int
+	if err := scope.Model(&models.Head{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	var heads []models.Head
+	err := scope.Order("number desc").Offset(offset).Limit(limit).Find(&heads).Error
+	return heads, count, err
+}
+
+// TrimOldHeads deletes the persisted heads of the chain but for the depth
+// most recent ones.
+func (orm *ORM) TrimOldHeads(chainID *utils.Big, depth uint64) error {
+	orm.MustEnsureAdvisoryLock()
+	onChain, args := "chain_id IS NULL", []interface{}{depth}
+	if chainID != nil {
+		onChain, args = "chain_id = ?", []interface{}{chainID, chainID, depth}
+	}
+	return orm.db.Exec(fmt.Sprintf(`
+	DELETE FROM heads
+	WHERE %[1]s AND id <= (
+	  SELECT id
+	  FROM (
+		SELECT id
+		FROM heads
+		WHERE %[1]s
+		ORDER BY id DESC
+		LIMIT 1 OFFSET ?
+	  ) foo
+	)`, onChain), args...).Error
+}
+
 // DeleteStaleSessions deletes all sessions before the passed time.
 func (orm *ORM) DeleteStaleSessions(before time.Time) error {
 	orm.MustEnsureAdvisoryLock()
@@ -1147,6 +1472,97 @@ func (orm *ORM) BulkDeleteRuns(bulkQuery *models.BulkDeleteRunRequest) error {
 	})
 }
 
+// PrunedRuns counts the rows removed by a single batch of run pruning.
+type PrunedRuns struct {
+	JobRuns     int64
+	TaskRuns    int64
+	RunResults  int64
+	RunRequests int64
+}
+
+// PruneJobRunsUpdatedBefore deletes up to limit job runs in one of the given
+// statuses that were last updated before the given time, along with their
+// task runs, run results and run requests.
+func (orm *ORM) PruneJobRunsUpdatedBefore(statuses models.RunStatusCollection, updatedBefore time.Time, limit uint32) (PrunedRuns, error) {
+	return orm.pruneJobRuns(`
+		SELECT id, result_id, run_request_id FROM job_runs
+		WHERE status IN (?) AND updated_at < ?
+		ORDER BY updated_at ASC
+		LIMIT ?`,
+		statuses.ToStrings(), updatedBefore, limit)
+}
+
+// PruneJobRunsExceedingCount deletes up to limit job runs in one of the
+// given statuses that are not among the keep most recently created finished
+// runs of their job, along with their task runs, run results and run requests.
+func (orm *ORM) PruneJobRunsExceedingCount(statuses models.RunStatusCollection, keep uint32, limit uint32) (PrunedRuns, error) {
+	return orm.pruneJobRuns(`
+		SELECT id, result_id, run_request_id FROM (
+			SELECT id, result_id, run_request_id, row_number() OVER (
+				PARTITION BY job_spec_id ORDER BY created_at DESC
+			) AS position
+			FROM job_runs
+			WHERE status IN (?)
+		) ranked
+		WHERE position > ?
+		LIMIT ?`,
+		statuses.ToStrings(), keep, limit)
+}
+
+// pruneJobRuns deletes the job runs selected by batchQuery, which must return
+// id, result_id and run_request_id columns, in a single short transaction.
+func (orm *ORM) pruneJobRuns(batchQuery string, args ...interface{}) (PrunedRuns, error) {
+	orm.MustEnsureAdvisoryLock()
+	var pruned PrunedRuns
+	err := orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		return dbtx.Raw(`
+			WITH batch AS (`+batchQuery+`),
+			deleted_task_runs AS (
+				DELETE FROM task_runs WHERE job_run_id IN (SELECT id FROM batch) RETURNING result_id
+			),
+			deleted_job_runs AS (
+				DELETE FROM job_runs WHERE id IN (SELECT id FROM batch) RETURNING id
+			),
+			deleted_run_results AS (
+				DELETE FROM run_results WHERE id IN (
+					SELECT result_id FROM batch UNION SELECT result_id FROM deleted_task_runs
+				) RETURNING id
+			),
+			deleted_run_requests AS (
+				DELETE FROM run_requests WHERE id IN (SELECT run_request_id FROM batch) RETURNING id
+			)
+			SELECT
+				(SELECT count(*) FROM deleted_job_runs),
+				(SELECT count(*) FROM deleted_task_runs),
+				(SELECT count(*) FROM deleted_run_results),
+				(SELECT count(*) FROM deleted_run_requests)`,
+			args...).Row().Scan(&pruned.JobRuns, &pruned.TaskRuns, &pruned.RunResults, &pruned.RunRequests)
+	})
+	return pruned, errors.Wrap(err, "error pruning JobRuns")
+}
+
+// PruneSyncEventsBefore deletes up to limit sync events created before the
+// given time, returning the number of rows removed.
+func (orm *ORM) PruneSyncEventsBefore(createdBefore time.Time, limit uint32) (int64, error) {
+	return orm.pruneCreatedBefore("sync_events", createdBefore, limit)
+}
+
+// PruneLogConsumptionsBefore deletes up to limit log consumption records
+// created before the given time, returning the number of rows removed.
+func (orm *ORM) PruneLogConsumptionsBefore(createdBefore time.Time, limit uint32) (int64, error) {
+	return orm.pruneCreatedBefore("log_consumptions", createdBefore, limit)
+}
+
+func (orm *ORM) pruneCreatedBefore(table string, createdBefore time.Time, limit uint32) (int64, error) {
+	orm.MustEnsureAdvisoryLock()
+	query := fmt.Sprintf(`
+		DELETE FROM %[1]s WHERE id IN (
+			SELECT id FROM %[1]s WHERE created_at < ? ORDER BY created_at ASC LIMIT ?
+		)`, table)
+	result := orm.db.Exec(query, createdBefore, limit)
+	return result.RowsAffected, errors.Wrapf(result.Error, "error pruning %s", table)
+}
+
 func (orm *ORM) Keys() ([]*models.Key, error) {
 	orm.MustEnsureAdvisoryLock()
 	var keys []*models.Key
@@ -1192,6 +1608,26 @@ func (orm *ORM) SaveLogCursor(logCursor *models.LogCursor) error {
 	return orm.db.Save(logCursor).Error
 }
 
+// AdvanceLogCursorsToBlock moves the named log cursors to the start of the
+// block in a single statement, creating those not saved yet. Cursors already
+// past the block stay where they are.
+func (orm *ORM) AdvanceLogCursorsToBlock(names []string, blockNumber uint64) error {
+	orm.MustEnsureAdvisoryLock()
+	if len(names) == 0 {
+		return nil
+	}
+	return orm.db.Exec(`
+		INSERT INTO log_cursors (name, initialized, block_index, log_index, updated_at)
+		SELECT name, true, ?, 0, NOW() FROM unnest(ARRAY[?]::text[]) AS name
+		ON CONFLICT (name) DO UPDATE SET
+			initialized = true,
+			block_index = EXCLUDED.block_index,
+			log_index = 0,
+			updated_at = EXCLUDED.updated_at
+		WHERE NOT log_cursors.initialized OR log_cursors.block_index < EXCLUDED.block_index
+	`, blockNumber, names).Error
+}
+
 // FindLogCursor will find the given log cursor.
 func (orm *ORM) FindLogCursor(name string) (models.LogCursor, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -1236,6 +1672,15 @@ func (orm *ORM) CreateLogConsumption(lc *models.LogConsumption) error {
 	return orm.db.Create(lc).Error
 }
 
+// DeleteLogConsumption deletes the record of the consumer having consumed the
+// log, so that it is delivered again if its block rejoins the chain.
+func (orm *ORM) DeleteLogConsumption(rawLog eth.RawLog, jobID *models.ID) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.
+		Where("block_hash = ? AND log_index = ? AND job_id = ?", rawLog.GetBlockHash(), rawLog.GetIndex(), jobID).
+		Delete(models.LogConsumption{}).Error
+}
+
 // FindLogConsumer finds the consuming job of a particular LogConsumption record
 func (orm *ORM) FindLogConsumer(lc *models.LogConsumption) (models.JobSpec, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -291,6 +291,46 @@ func TestORM_LinkEarnedFor(t *testing.T) {
 	assert.Equal(t, assets.NewLink(10), totalEarned)
 }
 
+func TestORM_RunCostsFor(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	completed := cltest.NewJobRun(job)
+	completed.TaskRuns[0].Status = models.RunStatusCompleted
+	completed.SetStatus(models.RunStatusCompleted)
+	completed.Payment = assets.NewLink(7)
+	completed.GasUsed = 21000
+	completed.EthSpent = assets.NewEth(300)
+	require.NoError(t, store.CreateJobRun(&completed))
+
+	errored := cltest.NewJobRun(job)
+	errored.SetStatus(models.RunStatusErrored)
+	errored.Payment = assets.NewLink(5)
+	errored.GasUsed = 30000
+	errored.EthSpent = assets.NewEth(400)
+	require.NoError(t, store.CreateJobRun(&errored))
+
+	unsent := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&unsent))
+
+	costs, err := store.RunCostsFor(&job, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, costs.Runs)
+	assert.Equal(t, assets.NewLink(7), costs.LinkEarned)
+	assert.Equal(t, uint64(51000), costs.GasUsed)
+	assert.Equal(t, assets.NewEth(700), costs.EthSpent)
+
+	costs, err = store.RunCostsFor(&job, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, costs.Runs)
+	assert.Equal(t, assets.NewEth(0), costs.EthSpent)
+}
+
 func TestORM_JobRunsSortedFor(t *testing.T) {
 	t.Parallel()
 
@@ -743,7 +783,7 @@ func TestORM_GetLastNonce_StormNotFound(t *testing.T) {
 	store := app.Store
 
 	account := cltest.GetAccountAddress(t, store)
-	nonce, err := store.GetLastNonce(account)
+	nonce, err := store.GetLastNonce(nil, account)
 
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(0), nonce)
@@ -769,7 +809,7 @@ func TestORM_GetLastNonce_Valid(t *testing.T) {
 	assert.NoError(t, err)
 
 	account := cltest.GetAccountAddress(t, store)
-	nonce, err := store.GetLastNonce(account)
+	nonce, err := store.GetLastNonce(nil, account)
 
 	assert.NoError(t, err)
 	assert.Equal(t, one, nonce)
@@ -1062,6 +1102,56 @@ func TestBulkDeleteRuns(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestORM_PruneJobRunsUpdatedBefore(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	orm := store.ORM
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, orm.CreateJob(&job))
+
+	var oldRuns []models.JobRun
+	for i := 0; i < 3; i++ {
+		run := cltest.NewJobRun(job)
+		run.SetStatus(models.RunStatusCompleted)
+		require.NoError(t, orm.CreateJobRun(&run))
+		oldRuns = append(oldRuns, run)
+	}
+	oldInProgressRun := cltest.NewJobRun(job)
+	require.NoError(t, orm.CreateJobRun(&oldInProgressRun))
+	newRun := cltest.NewJobRun(job)
+	newRun.SetStatus(models.RunStatusCompleted)
+	require.NoError(t, orm.CreateJobRun(&newRun))
+
+	require.NoError(t, orm.RawDB(func(db *gorm.DB) error {
+		return db.Exec("UPDATE job_runs SET updated_at = ? WHERE id <> ?",
+			cltest.ParseISO8601(t, "2018-01-01T00:00:00Z"), newRun.ID).Error
+	}))
+
+	statuses := models.RunStatusCollection{models.RunStatusCompleted}
+	updatedBefore := cltest.ParseISO8601(t, "2018-01-15T00:00:00Z")
+
+	pruned, err := orm.PruneJobRunsUpdatedBefore(statuses, updatedBefore, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pruned.JobRuns)
+	assert.Equal(t, int64(2), pruned.TaskRuns)
+	assert.Equal(t, int64(2), pruned.RunRequests)
+
+	pruned, err = orm.PruneJobRunsUpdatedBefore(statuses, updatedBefore, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned.JobRuns)
+
+	count, err := orm.JobRunsCountFor(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	for _, run := range oldRuns {
+		_, err := orm.FindJobRun(run.ID)
+		assert.Error(t, err)
+	}
+}
+
 func TestORM_FindTxsBySenderAndRecipient(t *testing.T) {
 	t.Parallel()
 
@@ -1423,7 +1513,7 @@ func TestORM_UnconfirmedTxAttempts(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	attempts, err := store.ORM.UnconfirmedTxAttempts()
+	attempts, err := store.ORM.UnconfirmedTxAttempts(nil)
 	require.NoError(t, err)
 
 	assert.Len(t, attempts, 7)
@@ -1442,13 +1532,147 @@ func TestORM_FindAllTxsInNonceRange(t *testing.T) {
 		createdTxs = append(createdTxs, *tx)
 	}
 
-	txs, err := store.FindAllTxsInNonceRange(2, 3)
+	txs, err := store.FindAllTxsInNonceRange(nil, 2, 3)
 	require.NoError(t, err)
 	assert.Len(t, txs, 2)
 	assert.Equal(t, "nonce-2", txs[0].SurrogateID.ValueOrZero())
 	assert.Equal(t, "nonce-3", txs[1].SurrogateID.ValueOrZero())
 }
 
+func TestORM_TxsOnChain(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	chainID := utils.NewBig(big.NewInt(100))
+	primaryTx, err := store.CreateTx(cltest.NewTransaction(1))
+	require.NoError(t, err)
+	chainTx := cltest.NewTransaction(2)
+	chainTx.ChainID = chainID
+	chainTx, err = store.CreateTx(chainTx)
+	require.NoError(t, err)
+	from := primaryTx.From
+
+	counts, err := store.UnconfirmedTxCounts(nil)
+	require.NoError(t, err)
+	assert.Len(t, counts, 1)
+	assert.Equal(t, 1, counts[from])
+	counts, err = store.UnconfirmedTxCounts(chainID)
+	require.NoError(t, err)
+	assert.Len(t, counts, 1)
+	assert.Equal(t, 1, counts[from])
+
+	nonce, err := store.GetLastNonce(nil, from)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+	nonce, err = store.GetLastNonce(chainID, from)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), nonce)
+
+	_, err = store.FindTxByNonce(nil, from, 2)
+	assert.Error(t, err)
+	tx, err := store.FindTxByNonce(chainID, from, 2)
+	require.NoError(t, err)
+	assert.Equal(t, chainTx.ID, tx.ID)
+
+	txs, err := store.FindAllTxsInNonceRange(chainID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	assert.Equal(t, chainTx.ID, txs[0].ID)
+}
+
+func TestORM_UnconfirmTxsAfter(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	transaction := cltest.NewTransaction(0)
+	tx, err := store.CreateTx(transaction)
+	require.NoError(t, err)
+	attempt, err := store.AddTxAttempt(tx, transaction)
+	require.NoError(t, err)
+	attempt.ReceiptBlockNumber = null.IntFrom(20)
+	require.NoError(t, store.MarkTxSafe(tx, attempt))
+
+	txs, err := store.UnconfirmTxsAfter(nil, 20)
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+	txs, err = store.UnconfirmTxsAfter(utils.NewBig(big.NewInt(100)), 19)
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+
+	txs, err = store.UnconfirmTxsAfter(nil, 19)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	assert.Equal(t, tx.ID, txs[0].ID)
+	assert.False(t, txs[0].Confirmed)
+	require.Len(t, txs[0].Attempts, 1)
+	assert.False(t, txs[0].Attempts[0].Confirmed)
+	assert.False(t, txs[0].Attempts[0].ReceiptBlockNumber.Valid)
+
+	txs, err = store.UnconfirmTxsAfter(nil, 19)
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+}
+
+func TestORM_HeadsAndTrimOldHeads(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	otherChainID := utils.NewBig(big.NewInt(42))
+	for idx := 0; idx < 10; idx++ {
+		require.NoError(t, store.CreateHead(cltest.Head(idx)))
+		other := cltest.Head(idx)
+		other.ChainID = otherChainID
+		require.NoError(t, store.CreateHead(other))
+	}
+
+	require.NoError(t, store.TrimOldHeads(nil, 4))
+
+	heads, count, err := store.Heads(nil, 0, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+	require.Len(t, heads, 3)
+	assert.Equal(t, int64(9), heads[0].Number)
+	assert.Equal(t, int64(7), heads[2].Number)
+
+	heads, count, err = store.Heads(otherChainID, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 10, count)
+	assert.Len(t, heads, 10)
+}
+
+func TestORM_AdvanceLogCursorsToBlock(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	behind := models.LogCursor{Name: "behind", Initialized: true, BlockIndex: 10, LogIndex: 3}
+	ahead := models.LogCursor{Name: "ahead", Initialized: true, BlockIndex: 80, LogIndex: 1}
+	require.NoError(t, store.SaveLogCursor(&behind))
+	require.NoError(t, store.SaveLogCursor(&ahead))
+
+	require.NoError(t, store.AdvanceLogCursorsToBlock([]string{"behind", "ahead", "new"}, 50))
+
+	cursor, err := store.FindLogCursor("behind")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(50), cursor.BlockIndex)
+	assert.Equal(t, uint64(0), cursor.LogIndex)
+
+	cursor, err = store.FindLogCursor("ahead")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(80), cursor.BlockIndex)
+	assert.Equal(t, uint64(1), cursor.LogIndex)
+
+	cursor, err = store.FindLogCursor("new")
+	require.NoError(t, err)
+	assert.True(t, cursor.Initialized)
+	assert.Equal(t, uint64(50), cursor.BlockIndex)
+
+	require.NoError(t, store.AdvanceLogCursorsToBlock(nil, 60))
+}
+
 func TestJobs_All(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
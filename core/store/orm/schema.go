@@ -15,6 +15,9 @@ import (
 // ConfigSchema records the schema of configuration at the type level
 type ConfigSchema struct {
 	AllowOrigins                    string          `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
+	BalanceMonitorAlertThreshold    assets.Eth      `env:"BALANCE_MONITOR_ALERT_THRESHOLD" default:"0"`
+	BalanceMonitorEnabled           bool            `env:"BALANCE_MONITOR_ENABLED" default:"true"`
+	BalanceMonitorWebhookURL        url.URL         `env:"BALANCE_MONITOR_WEBHOOK_URL"`
 	BridgeResponseURL               url.URL         `env:"BRIDGE_RESPONSE_URL"`
 	ChainID                         big.Int         `env:"ETH_CHAIN_ID" default:"1"`
 	ClientNodeURL                   string          `env:"CLIENT_NODE_URL" default:"http://localhost:6688"`
@@ -28,20 +31,46 @@ type ConfigSchema struct {
 	FeatureFluxMonitor              bool            `env:"FEATURE_FLUX_MONITOR" default:"false"`
 	MaximumServiceDuration          models.Duration `env:"MAXIMUM_SERVICE_DURATION" default:"8760h" `
 	MinimumServiceDuration          models.Duration `env:"MINIMUM_SERVICE_DURATION" default:"0s" `
+	EthEIP1559                      bool            `env:"ETH_EIP1559" default:"false"`
+	EthFinalityTag                  string          `env:"ETH_FINALITY_TAG" default:"latest"`
 	EthGasBumpThreshold             uint64          `env:"ETH_GAS_BUMP_THRESHOLD" default:"12" `
 	EthGasBumpWei                   big.Int         `env:"ETH_GAS_BUMP_WEI" default:"5000000000"`
 	EthGasBumpPercent               uint16          `env:"ETH_GAS_BUMP_PERCENT" default:"10"`
 	EthGasLimitDefault              uint64          `env:"ETH_GAS_LIMIT_DEFAULT" default:"500000"`
+	EthGasLimitEstimationEnabled    bool            `env:"ETH_GAS_LIMIT_ESTIMATION_ENABLED" default:"false"`
+	EthGasLimitMax                  uint64          `env:"ETH_GAS_LIMIT_MAX" default:"8000000"`
+	EthGasLimitMultiplier           float64         `env:"ETH_GAS_LIMIT_MULTIPLIER" default:"1.25"`
 	EthGasPriceDefault              big.Int         `env:"ETH_GAS_PRICE_DEFAULT" default:"20000000000"`
+	EthGasTipCapDefault             big.Int         `env:"ETH_GAS_TIP_CAP_DEFAULT" default:"1000000000"`
+	EthHeadHistoryDepth             uint64          `env:"ETH_HEAD_HISTORY_DEPTH" default:"100"`
+	EthKeyMinBalance                assets.Eth      `env:"ETH_KEY_MIN_BALANCE" default:"0"`
+	EthKeySelectionPolicy           string          `env:"ETH_KEY_SELECTION_POLICY" default:"round-robin"`
+	EthLogBackfillBatchSize         uint64          `env:"ETH_LOG_BACKFILL_BATCH_SIZE" default:"1000"`
 	EthMaxGasPriceWei               uint64          `env:"ETH_MAX_GAS_PRICE_WEI" default:"500000000000"`
+	EthMaxHeadAge                   models.Duration `env:"ETH_MAX_HEAD_AGE" default:"0s"`
+	EthNodeCallTimeout              models.Duration `env:"ETH_NODE_CALL_TIMEOUT" default:"30s"`
+	EthNodeMaxErrorRate             float64         `env:"ETH_NODE_MAX_ERROR_RATE" default:"0.5"`
+	EthNodeMaxHeadLag               uint64          `env:"ETH_NODE_MAX_HEAD_LAG" default:"5"`
+	EthNodePollInterval             models.Duration `env:"ETH_NODE_POLL_INTERVAL" default:"10s"`
+	EthNonceGapRepairThreshold      uint64          `env:"ETH_NONCE_GAP_REPAIR_THRESHOLD" default:"5"`
+	EthPauseEmptyKeys               bool            `env:"ETH_PAUSE_EMPTY_KEYS" default:"false"`
+	EthPollInterval                 models.Duration `env:"ETH_POLL_INTERVAL" default:"5s"`
+	EthRPCBatchSize                 int             `env:"ETH_RPC_BATCH_SIZE" default:"100"`
+	EthSyncCheckInterval            models.Duration `env:"ETH_SYNC_CHECK_INTERVAL" default:"30s"`
 	EthereumURL                     string          `env:"ETH_URL" default:"ws://localhost:8546"`
+	EthereumSecondaryURLs           string          `env:"ETH_SECONDARY_URLS"`
+	EthChains                       string          `env:"ETH_CHAINS"`
 	EthereumDisabled                bool            `env:"ETH_DISABLED" default:"false"`
 	GasUpdaterBlockDelay            uint16          `env:"GAS_UPDATER_BLOCK_DELAY" default:"3"`
 	GasUpdaterBlockHistorySize      uint16          `env:"GAS_UPDATER_BLOCK_HISTORY_SIZE" default:"24"`
 	GasUpdaterTransactionPercentile uint16          `env:"GAS_UPDATER_TRANSACTION_PERCENTILE" default:"35"`
 	GasUpdaterEnabled               bool            `env:"GAS_UPDATER_ENABLED" default:"false"`
+	JobMaxInFlightRuns              uint32          `env:"JOB_MAX_IN_FLIGHT_RUNS" default:"0"`
+	JobRunRateLimit                 uint32          `env:"JOB_RUN_RATE_LIMIT" default:"0"`
+	JobRunRateLimitInterval         models.Duration `env:"JOB_RUN_RATE_LIMIT_INTERVAL" default:"1m"`
 	JSONConsole                     bool            `env:"JSON_CONSOLE" default:"false"`
 	LinkContractAddress             string          `env:"LINK_CONTRACT_ADDRESS" default:"0x514910771AF9Ca656af840dff83E8264EcF986CA"`
+	LinkPerEth                      float64         `env:"LINK_PER_ETH" default:"0"`
 	ExplorerURL                     *url.URL        `env:"EXPLORER_URL"`
 	ExplorerAccessKey               string          `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret                  string          `env:"EXPLORER_SECRET"`
@@ -61,6 +90,12 @@ type ConfigSchema struct {
 	ReaperExpiration                models.Duration `env:"REAPER_EXPIRATION" default:"240h"`
 	ReplayFromBlock                 int64           `env:"REPLAY_FROM_BLOCK" default:"-1"`
 	RootDir                         string          `env:"ROOT" default:"~/.chainlink"`
+	RunReaperInterval               models.Duration `env:"RUN_REAPER_INTERVAL" default:"1h"`
+	RunRequestDedupWindow           models.Duration `env:"RUN_REQUEST_DEDUP_WINDOW" default:"24h"`
+	RunRetentionBatchSize           uint32          `env:"RUN_RETENTION_BATCH_SIZE" default:"1000"`
+	RunRetentionMaxAge              models.Duration `env:"RUN_RETENTION_MAX_AGE" default:"0s"`
+	RunRetentionMaxRunsPerJob       uint32          `env:"RUN_RETENTION_MAX_RUNS_PER_JOB" default:"0"`
+	RunRetentionStatuses            string          `env:"RUN_RETENTION_STATUSES" default:"completed,errored,cancelled"`
 	SecureCookies                   bool            `env:"SECURE_COOKIES" default:"true"`
 	SessionTimeout                  models.Duration `env:"SESSION_TIMEOUT" default:"15m"`
 	TLSCertPath                     string          `env:"TLS_CERT_PATH" `
@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/auth"
@@ -27,6 +28,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 	"go.uber.org/multierr"
+	null "gopkg.in/guregu/null.v3"
 )
 
 type requestType int
@@ -264,6 +266,38 @@ func (c *ConfigWhitelist) SetID(value string) error {
 type JobSpec struct {
 	models.JobSpec
 	Earnings *assets.Link `json:"earnings"`
+	Costs    []JobCosts   `json:"costs,omitempty"`
+}
+
+// JobCosts reports what a job's runs earned in LINK and spent in gas over a
+// time window. Profit is only present when the LINK/ETH rate is configured.
+type JobCosts struct {
+	Window     string       `json:"window"`
+	Runs       int          `json:"runs"`
+	LinkEarned *assets.Link `json:"linkEarned"`
+	GasUsed    uint64       `json:"gasUsed"`
+	EthSpent   *assets.Eth  `json:"ethSpent"`
+	Profit     *assets.Link `json:"profit"`
+}
+
+// NewJobCosts returns the costs for the given window, converting the ETH
+// spent to LINK at linkPerEth to work out the profit.
+func NewJobCosts(window string, costs orm.RunCosts, linkPerEth float64) JobCosts {
+	jc := JobCosts{
+		Window:     window,
+		Runs:       costs.Runs,
+		LinkEarned: costs.LinkEarned,
+		GasUsed:    costs.GasUsed,
+		EthSpent:   costs.EthSpent,
+	}
+	if linkPerEth > 0 {
+		spentInLink, _ := new(big.Float).Mul(
+			new(big.Float).SetInt(costs.EthSpent.ToInt()),
+			big.NewFloat(linkPerEth),
+		).Int(nil)
+		jc.Profit = (*assets.Link)(new(big.Int).Sub(costs.LinkEarned.ToInt(), spentInLink))
+	}
+	return jc
 }
 
 // MarshalJSON returns the JSON data of the Job and its Initiators.
@@ -563,33 +597,35 @@ func (a NewAccount) GetName() string {
 
 // Tx is a jsonapi wrapper for an Ethereum Transaction.
 type Tx struct {
-	Confirmed bool            `json:"confirmed,omitempty"`
-	Data      hexutil.Bytes   `json:"data,omitempty"`
-	From      *common.Address `json:"from,omitempty"`
-	GasLimit  string          `json:"gasLimit,omitempty"`
-	GasPrice  string          `json:"gasPrice,omitempty"`
-	Hash      common.Hash     `json:"hash,omitempty"`
-	Hex       string          `json:"rawHex,omitempty"`
-	Nonce     string          `json:"nonce,omitempty"`
-	SentAt    string          `json:"sentAt,omitempty"`
-	To        *common.Address `json:"to,omitempty"`
-	Value     string          `json:"value,omitempty"`
+	Confirmed    bool            `json:"confirmed,omitempty"`
+	Data         hexutil.Bytes   `json:"data,omitempty"`
+	From         *common.Address `json:"from,omitempty"`
+	GasLimit     string          `json:"gasLimit,omitempty"`
+	GasPrice     string          `json:"gasPrice,omitempty"`
+	Hash         common.Hash     `json:"hash,omitempty"`
+	Hex          string          `json:"rawHex,omitempty"`
+	Nonce        string          `json:"nonce,omitempty"`
+	SentAt       string          `json:"sentAt,omitempty"`
+	To           *common.Address `json:"to,omitempty"`
+	Value        string          `json:"value,omitempty"`
+	RevertReason null.String     `json:"revertReason"`
 }
 
 // NewTx builds a transaction presenter.
 func NewTx(tx *models.Tx) Tx {
 	return Tx{
-		Confirmed: tx.Confirmed,
-		Data:      hexutil.Bytes(tx.Data),
-		From:      &tx.From,
-		GasLimit:  strconv.FormatUint(tx.GasLimit, 10),
-		GasPrice:  tx.GasPrice.String(),
-		Hash:      tx.Hash,
-		Hex:       hexutil.Encode(tx.SignedRawTx),
-		Nonce:     strconv.FormatUint(tx.Nonce, 10),
-		SentAt:    strconv.FormatUint(tx.SentAt, 10),
-		To:        &tx.To,
-		Value:     tx.Value.String(),
+		Confirmed:    tx.Confirmed,
+		Data:         hexutil.Bytes(tx.Data),
+		From:         &tx.From,
+		GasLimit:     strconv.FormatUint(tx.GasLimit, 10),
+		GasPrice:     tx.GasPrice.String(),
+		Hash:         tx.Hash,
+		Hex:          hexutil.Encode(tx.SignedRawTx),
+		Nonce:        strconv.FormatUint(tx.Nonce, 10),
+		SentAt:       strconv.FormatUint(tx.SentAt, 10),
+		To:           &tx.To,
+		Value:        tx.Value.String(),
+		RevertReason: tx.RevertReason,
 	}
 }
 
@@ -632,6 +668,56 @@ func (t *Tx) SetID(hex string) error {
 	return nil
 }
 
+// Head is a jsonapi wrapper for a head the node received, with how long it
+// took to arrive and how far behind the chain it is.
+type Head struct {
+	Number     int64       `json:"number"`
+	Hash       common.Hash `json:"hash"`
+	ParentHash common.Hash `json:"parentHash"`
+	ChainID    *utils.Big  `json:"chainId,omitempty"`
+	Timestamp  null.Time   `json:"timestamp"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	LatencyMs  null.Int    `json:"latencyMs"`
+	Depth      null.Int    `json:"depth"`
+}
+
+// NewHead builds a head presenter. The depth, the number of blocks the chain
+// has grown past the head, is only set if the height of the chain is known.
+func NewHead(head models.Head, chainHeight *big.Int) Head {
+	h := Head{
+		Number:     head.Number,
+		Hash:       head.Hash,
+		ParentHash: head.ParentHash,
+		ChainID:    head.ChainID,
+		Timestamp:  head.Timestamp,
+		CreatedAt:  head.CreatedAt,
+	}
+	if head.Timestamp.Valid {
+		h.LatencyMs = null.IntFrom(head.CreatedAt.Sub(head.Timestamp.Time).Milliseconds())
+	}
+	if chainHeight != nil {
+		h.Depth = null.IntFrom(chainHeight.Int64() - head.Number)
+	}
+	return h
+}
+
+// GetID returns the jsonapi ID.
+func (h Head) GetID() string {
+	return h.Hash.Hex()
+}
+
+// GetName returns the collection name for jsonapi.
+func (Head) GetName() string {
+	return "heads"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (h *Head) SetID(hex string) error {
+	h.Hash = common.HexToHash(hex)
+	return nil
+}
+
 // ExternalInitiatorAuthentication includes initiator and authentication details.
 type ExternalInitiatorAuthentication struct {
 	Name           string        `json:"name,omitempty"`
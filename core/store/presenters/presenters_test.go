@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -35,3 +37,25 @@ func TestTx_MarshalJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, string(b))
 }
+
+func TestNewJobCosts(t *testing.T) {
+	t.Parallel()
+
+	costs := orm.RunCosts{
+		Runs:       3,
+		LinkEarned: assets.NewLink(1000),
+		GasUsed:    63000,
+		EthSpent:   assets.NewEth(100),
+	}
+
+	jc := NewJobCosts("24h", costs, 0)
+	assert.Equal(t, "24h", jc.Window)
+	assert.Equal(t, 3, jc.Runs)
+	assert.Nil(t, jc.Profit)
+
+	jc = NewJobCosts("24h", costs, 2.5)
+	assert.Equal(t, assets.NewLink(750), jc.Profit)
+
+	jc = NewJobCosts("24h", costs, 20)
+	assert.Equal(t, assets.NewLink(-1000), jc.Profit)
+}
@@ -23,7 +23,8 @@ var promETHBalance = promauto.NewGaugeVec(
 	[]string{"account"},
 )
 
-func promUpdateEthBalance(balance *assets.Eth, from common.Address) {
+// PromUpdateEthBalance sets the eth_balance gauge of the given account.
+func PromUpdateEthBalance(balance *assets.Eth, from common.Address) {
 	balanceFloat, err := approximateFloat64(balance)
 
 	if err != nil {
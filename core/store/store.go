@@ -39,33 +39,56 @@ type Store struct {
 	KeyStore    KeyStoreInterface
 	VRFKeyStore *VRFKeyStore
 	TxManager   TxManager
-	closeOnce   *sync.Once
+	// ChainID is the chain of a store for one of ETH_CHAINS, nil for the
+	// primary chain.
+	ChainID   *utils.Big
+	chains    []*Store
+	ethPool   *eth.Pool
+	closeOnce *sync.Once
 }
 
+// ErrUnknownChain is returned for a chain ID that is neither ETH_CHAIN_ID nor
+// in ETH_CHAINS.
+var ErrUnknownChain = errors.New("chain is not ETH_CHAIN_ID or in ETH_CHAINS")
+
 type lazyRPCWrapper struct {
-	client      *rpc.Client
-	url         *url.URL
-	mutex       *sync.Mutex
-	initialized *abool.AtomicBool
-	limiter     *rate.Limiter
+	client       *rpc.Client
+	url          *url.URL
+	mutex        *sync.Mutex
+	initialized  *abool.AtomicBool
+	limiter      *rate.Limiter
+	pollInterval time.Duration
 }
 
-func newLazyRPCWrapper(urlString string, limiter *rate.Limiter) (eth.CallerSubscriber, error) {
+func newLazyRPCWrapper(urlString string, limiter *rate.Limiter, pollInterval time.Duration) (eth.CallerSubscriber, error) {
 	parsed, err := url.ParseRequestURI(urlString)
 	if err != nil {
 		return nil, err
 	}
-	if parsed.Scheme != "ws" && parsed.Scheme != "wss" {
-		return nil, fmt.Errorf("Ethereum url scheme must be websocket: %s", parsed.String())
+	switch parsed.Scheme {
+	case "ws", "wss":
+	case "http", "https":
+		if pollInterval <= 0 {
+			return nil, fmt.Errorf("ETH_POLL_INTERVAL must be positive for an HTTP Ethereum url: %s", parsed.String())
+		}
+	default:
+		return nil, fmt.Errorf("Ethereum url scheme must be websocket or http: %s", parsed.String())
 	}
 	return &lazyRPCWrapper{
-		url:         parsed,
-		mutex:       &sync.Mutex{},
-		initialized: abool.New(),
-		limiter:     limiter,
+		url:          parsed,
+		mutex:        &sync.Mutex{},
+		initialized:  abool.New(),
+		limiter:      limiter,
+		pollInterval: pollInterval,
 	}, nil
 }
 
+// isHTTP is true when the node is reached over HTTP, which has no
+// subscriptions.
+func (wrapper *lazyRPCWrapper) isHTTP() bool {
+	return wrapper.url.Scheme == "http" || wrapper.url.Scheme == "https"
+}
+
 // lazyDialInitializer initializes the Dial instance used to interact with
 // an ethereum node using the Double-checked locking optimization:
 // https://en.wikipedia.org/wiki/Double-checked_locking
@@ -101,12 +124,33 @@ func (wrapper *lazyRPCWrapper) Call(result interface{}, method string, args ...i
 	return wrapper.client.Call(result, method, args...)
 }
 
+// BatchCall sends the calls in one request, which counts once against the
+// rate limit.
+func (wrapper *lazyRPCWrapper) BatchCall(b []rpc.BatchElem) error {
+	err := wrapper.lazyDialInitializer()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	wrapper.limiter.Wait(ctx)
+
+	return wrapper.client.BatchCall(b)
+}
+
+// Subscribe subscribes over the websocket, or polls the node with rate
+// limited calls when it is reached over HTTP.
 func (wrapper *lazyRPCWrapper) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (eth.Subscription, error) {
 	err := wrapper.lazyDialInitializer()
 	if err != nil {
 		return nil, err
 	}
 
+	if wrapper.isHTTP() {
+		return eth.SubscribeByPolling(ctx, wrapper, wrapper.pollInterval, channel, args...)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	wrapper.limiter.Wait(ctx)
@@ -121,24 +165,28 @@ type Dialer interface {
 
 // EthDialer is Dialer which accesses rpc urls
 type EthDialer struct {
-	limiter *rate.Limiter
+	limiter      *rate.Limiter
+	pollInterval time.Duration
 }
 
-// NewEthDialer returns an eth dialer with the specified rate limit
-func NewEthDialer(rateLimit uint64) *EthDialer {
+// NewEthDialer returns an eth dialer with the specified rate limit, which
+// polls nodes at HTTP urls every pollInterval
+func NewEthDialer(rateLimit uint64, pollInterval time.Duration) *EthDialer {
 	return &EthDialer{
-		limiter: rate.NewLimiter(rate.Limit(rateLimit), 1),
+		limiter:      rate.NewLimiter(rate.Limit(rateLimit), 1),
+		pollInterval: pollInterval,
 	}
 }
 
 // Dial will dial the given url and return a CallerSubscriber
 func (ed *EthDialer) Dial(urlString string) (eth.CallerSubscriber, error) {
-	return newLazyRPCWrapper(urlString, ed.limiter)
+	return newLazyRPCWrapper(urlString, ed.limiter, ed.pollInterval)
 }
 
 // NewStore will create a new store using the Eth dialer
 func NewStore(config *orm.Config, shutdownSignal gracefulpanic.Signal) *Store {
-	return NewStoreWithDialer(config, NewEthDialer(config.MaxRPCCallsPerSecond()), shutdownSignal)
+	dialer := NewEthDialer(config.MaxRPCCallsPerSecond(), config.EthPollInterval().Duration())
+	return NewStoreWithDialer(config, dialer, shutdownSignal)
 }
 
 // NewStoreWithDialer creates a new store with the given config and dialer
@@ -151,7 +199,7 @@ func NewStoreWithDialer(config *orm.Config, dialer Dialer, shutdownSignal gracef
 // dialer, using an insecure keystore.
 // NOTE: Should only be used for testing!
 func NewInsecureStore(config *orm.Config, shutdownSignal gracefulpanic.Signal) *Store {
-	dialer := NewEthDialer(config.MaxRPCCallsPerSecond())
+	dialer := NewEthDialer(config.MaxRPCCallsPerSecond(), config.EthPollInterval().Duration())
 	keyStore := func() *KeyStore { return NewInsecureKeyStore(config.KeysDir()) }
 	return newStoreWithDialerAndKeyStore(config, dialer, keyStore, shutdownSignal)
 }
@@ -171,7 +219,7 @@ func newStoreWithDialerAndKeyStore(
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to initialize ORM: %+v", err))
 	}
-	ethrpc, err := dialer.Dial(config.EthereumURL())
+	ethrpc, ethPool, err := dialEthereum(config, dialer)
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to dial ETH RPC port: %+v", err))
 	}
@@ -188,15 +236,145 @@ func newStoreWithDialerAndKeyStore(
 		KeyStore:  keyStore,
 		ORM:       orm,
 		TxManager: txManager,
+		ethPool:   ethPool,
 		closeOnce: &sync.Once{},
 	}
 	store.VRFKeyStore = NewVRFKeyStore(store)
+
+	chainConfigs, err := config.EthChains()
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Unable to load ETH_CHAINS: %+v", err))
+	}
+	for _, chainConfig := range chainConfigs {
+		chain, err := store.newChainStore(chainConfig, dialer)
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("Unable to dial ETH RPC port of chain %s: %+v", chainConfig.ChainID(), err))
+		}
+		store.chains = append(store.chains, chain)
+	}
 	return store
 }
 
+// newChainStore returns the store of an additional chain, which shares the
+// database and keys of the primary store but has its own Ethereum node and
+// TxManager.
+func (s *Store) newChainStore(config *orm.Config, dialer Dialer) (*Store, error) {
+	ethrpc, ethPool, err := dialEthereum(config, dialer)
+	if err != nil {
+		return nil, err
+	}
+	callerSubscriberClient := &eth.CallerSubscriberClient{CallerSubscriber: ethrpc}
+	chainID := utils.NewBig(config.ChainID())
+	txManager := NewEthTxManager(callerSubscriberClient, config, s.KeyStore, s.ORM)
+	txManager.ChainID = chainID
+	return &Store{
+		ORM:         s.ORM,
+		Config:      config,
+		Clock:       s.Clock,
+		KeyStore:    s.KeyStore,
+		VRFKeyStore: s.VRFKeyStore,
+		TxManager:   txManager,
+		ChainID:     chainID,
+		ethPool:     ethPool,
+		closeOnce:   &sync.Once{},
+	}, nil
+}
+
+// Chains returns the stores of the additional chains in ETH_CHAINS.
+func (s *Store) Chains() []*Store {
+	return s.chains
+}
+
+// ForChain returns the store of the chain, which is this store for a nil
+// chain ID or ETH_CHAIN_ID.
+func (s *Store) ForChain(chainID *utils.Big) (*Store, error) {
+	if s.OnChain(chainID) {
+		return s, nil
+	}
+	for _, chain := range s.chains {
+		if chain.OnChain(chainID) {
+			return chain, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrUnknownChain, "chain %s", chainID)
+}
+
+// ForJob returns the store of the chain the job runs on.
+func (s *Store) ForJob(jobID *models.ID) (*Store, error) {
+	if len(s.chains) == 0 {
+		return s, nil
+	}
+	chainID, err := s.FindJobChainID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	return s.ForChain(chainID)
+}
+
+// OnChain returns whether the chain ID is the chain of this store. A nil
+// chain ID is the primary chain.
+func (s *Store) OnChain(chainID *utils.Big) bool {
+	if chainID == nil {
+		return s.ChainID == nil
+	}
+	return chainID.ToInt().Cmp(s.Config.ChainID()) == 0
+}
+
+// dialEthereum dials the node at ETH_URL. If ETH_SECONDARY_URLS are set, it
+// also dials those and returns a pool of the nodes, which fails over between
+// them.
+func dialEthereum(config *orm.Config, dialer Dialer) (eth.CallerSubscriber, *eth.Pool, error) {
+	primary, err := dialBatching(config, dialer, config.EthereumURL())
+	if err != nil {
+		return nil, nil, err
+	}
+	secondaryURLs := config.EthereumSecondaryURLs()
+	if len(secondaryURLs) == 0 {
+		return primary, nil, nil
+	}
+
+	nodes := []eth.PoolNode{{Name: "primary", Primary: true, CallerSubscriber: primary}}
+	for i, url := range secondaryURLs {
+		secondary, err := dialBatching(config, dialer, url)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "secondary Ethereum node %d", i+1)
+		}
+		nodes = append(nodes, eth.PoolNode{Name: fmt.Sprintf("secondary-%d", i+1), CallerSubscriber: secondary})
+	}
+	pool := eth.NewPool(nodes, eth.PoolConfig{
+		PollInterval: config.EthNodePollInterval().Duration(),
+		MaxHeadLag:   config.EthNodeMaxHeadLag(),
+		MaxErrorRate: config.EthNodeMaxErrorRate(),
+		CallTimeout:  config.EthNodeCallTimeout().Duration(),
+	})
+	return pool, pool, nil
+}
+
+// dialBatching dials the node at the url, batching concurrent calls to it
+// when the node supports batches.
+func dialBatching(config *orm.Config, dialer Dialer, url string) (eth.CallerSubscriber, error) {
+	node, err := dialer.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	if batcher, ok := node.(eth.BatchCallerSubscriber); ok && config.EthRPCBatchSize() > 1 {
+		return eth.NewBatchingCallerSubscriber(batcher, config.EthRPCBatchSize()), nil
+	}
+	return node, nil
+}
+
 // Start initiates all of Store's dependencies including the TxManager.
 func (s *Store) Start() error {
+	if s.ethPool != nil {
+		s.ethPool.Start()
+	}
 	s.TxManager.Register(s.KeyStore.Accounts())
+	for _, chain := range s.chains {
+		if chain.ethPool != nil {
+			chain.ethPool.Start()
+		}
+		chain.TxManager.Register(s.KeyStore.Accounts())
+	}
 	return s.SyncDiskKeyStoreToDB()
 }
 
@@ -204,6 +382,14 @@ func (s *Store) Start() error {
 func (s *Store) Close() error {
 	var err error
 	s.closeOnce.Do(func() {
+		if s.ethPool != nil {
+			s.ethPool.Close()
+		}
+		for _, chain := range s.chains {
+			if chain.ethPool != nil {
+				chain.ethPool.Close()
+			}
+		}
 		err = s.ORM.Close()
 	})
 	return err
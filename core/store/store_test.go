@@ -11,8 +11,10 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -43,6 +45,40 @@ func TestStore_Close(t *testing.T) {
 	assert.NoError(t, s.Close())
 }
 
+func TestStore_ForChain(t *testing.T) {
+	t.Parallel()
+
+	config, configCleanup := cltest.NewConfig(t)
+	defer configCleanup()
+	config.Set("ETH_CHAINS", `[{"ETH_CHAIN_ID": "100", "ETH_URL": "ws://xdai:8546"}]`)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	require.Len(t, store.Chains(), 1)
+	xdai := store.Chains()[0]
+	assert.Equal(t, utils.NewBig(big.NewInt(100)), xdai.ChainID)
+	assert.Equal(t, "ws://xdai:8546", xdai.Config.EthereumURL())
+
+	chain, err := store.ForChain(nil)
+	require.NoError(t, err)
+	assert.Equal(t, store, chain)
+	chain, err = store.ForChain(utils.NewBig(store.Config.ChainID()))
+	require.NoError(t, err)
+	assert.Equal(t, store, chain)
+	chain, err = store.ForChain(utils.NewBig(big.NewInt(100)))
+	require.NoError(t, err)
+	assert.Equal(t, xdai, chain)
+	_, err = store.ForChain(utils.NewBig(big.NewInt(42)))
+	assert.Equal(t, strpkg.ErrUnknownChain, errors.Cause(err))
+
+	job := cltest.NewJobWithWebInitiator()
+	job.ChainID = utils.NewBig(big.NewInt(100))
+	require.NoError(t, store.CreateJob(&job))
+	chain, err = store.ForJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, xdai, chain)
+}
+
 func TestStore_SyncDiskKeyStoreToDB_HappyPath(t *testing.T) {
 	t.Parallel()
 
@@ -19,7 +19,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tevino/abool"
@@ -58,8 +60,41 @@ var (
 		Name: "tx_manager_tx_attempt_failed",
 		Help: "Number of tx attempts that failed. Tx attempts should not fail in normal operation.",
 	})
+	promNonceGapsFilled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tx_manager_nonce_gaps_filled",
+		Help: "Number of nonces missing from the chain that were filled, by rebroadcasting their transaction or sending a zero value transaction",
+	})
 )
 
+// cancelTxGasLimit is the gas used by a transfer without data, as sent to
+// cancel a transaction or fill a nonce gap.
+const cancelTxGasLimit = 21000
+
+// ErrTxAlreadyConfirmed is returned when cancelling a transaction that has
+// already been confirmed.
+var ErrTxAlreadyConfirmed = errors.New("transaction has already been confirmed")
+
+// ErrTxOnOtherChain is returned when cancelling a transaction that was sent
+// on another chain than the TxManager's.
+var ErrTxOnOtherChain = errors.New("transaction was sent on another chain")
+
+// ErrUnknownAccount is returned when a transaction is pinned to an address
+// that is not one of the node's keys.
+var ErrUnknownAccount = errors.New("address is not one of the node's keys")
+
+// ErrKeyBelowMinimumBalance is returned when a transaction is pinned to a key
+// whose ETH balance is below ETH_KEY_MIN_BALANCE.
+var ErrKeyBelowMinimumBalance = errors.New("key has an ETH balance below ETH_KEY_MIN_BALANCE, or is empty")
+
+// ErrGasLimitExceedsMax is returned when the gas estimate of a transaction
+// is above ETH_GAS_LIMIT_MAX. Retrying would not lower it, so the transaction
+// is never sent.
+var ErrGasLimitExceedsMax = errors.New("estimated gas limit exceeds ETH_GAS_LIMIT_MAX")
+
+// ErrNoFundedAccounts is returned when every key's ETH balance is below
+// ETH_KEY_MIN_BALANCE.
+var ErrNoFundedAccounts = errors.New("no key has an ETH balance of at least ETH_KEY_MIN_BALANCE")
+
 //go:generate mockery -name TxManager -output ../internal/mocks/ -case=underscore
 
 // TxManager represents an interface for interacting with the blockchain
@@ -70,15 +105,19 @@ type TxManager interface {
 
 	CreateTx(to common.Address, data []byte) (*models.Tx, error)
 	CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error)
+	CreateTxFrom(surrogateID null.String, from, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error)
 	CreateTxWithEth(from, to common.Address, value *assets.Eth) (*models.Tx, error)
 	CheckAttempt(txAttempt *models.TxAttempt, blockHeight uint64) (*eth.TxReceipt, AttemptState, error)
+	FinalizedHeight(tag models.FinalityTag) (*big.Int, error)
 
 	BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, AttemptState, error)
+	CancelTx(hash common.Hash) (*models.Tx, error)
 
 	ContractLINKBalance(wr models.WithdrawalRequest) (assets.Link, error)
 	WithdrawLINK(wr models.WithdrawalRequest) (common.Hash, error)
 	GetLINKBalance(address common.Address) (*assets.Link, error)
 	NextActiveAccount() *ManagedAccount
+	GetAvailableAccount(from common.Address) *ManagedAccount
 
 	SignedRawTxWithBumpedGas(originalTx models.Tx, gasLimit uint64, gasPrice big.Int) ([]byte, error)
 
@@ -98,18 +137,32 @@ type EthTxManager struct {
 	accountsMutex       *sync.Mutex
 	connected           *abool.AtomicBool
 	currentHead         models.Head
+	finalizedHeights    map[models.FinalityTag]*big.Int
+	finalizedMutex      sync.Mutex
+	chHeadWork          chan models.Head
+	chStopHeadWork      chan struct{}
+	headWorkMutex       sync.Mutex
+	reorgedTxIDs        map[uint64]struct{}
+	reorgedMutex        sync.Mutex
+	// ChainID is the chain of a TxManager for one of ETH_CHAINS, nil for the
+	// primary chain. Transactions are recorded with it, so that each chain
+	// only rebroadcasts, counts and repairs its own.
+	ChainID *utils.Big
 }
 
 // NewEthTxManager constructs an EthTxManager using the passed variables and
 // initializing internal variables.
 func NewEthTxManager(client eth.Client, config orm.ConfigReader, keyStore KeyStoreInterface, orm *orm.ORM) *EthTxManager {
 	return &EthTxManager{
-		Client:        client,
-		config:        config,
-		keyStore:      keyStore,
-		orm:           orm,
-		accountsMutex: &sync.Mutex{},
-		connected:     abool.New(),
+		Client:           client,
+		config:           config,
+		keyStore:         keyStore,
+		orm:              orm,
+		accountsMutex:    &sync.Mutex{},
+		connected:        abool.New(),
+		finalizedHeights: make(map[models.FinalityTag]*big.Int),
+		chHeadWork:       make(chan models.Head, 1),
+		reorgedTxIDs:     make(map[uint64]struct{}),
 	}
 }
 
@@ -148,12 +201,17 @@ func (txm *EthTxManager) Connect(bn *models.Head) error {
 
 		if bn != nil {
 			txm.currentHead = *bn
+			txm.resetFinalizedHeights()
 		}
 		txm.connected.Set()
 	}()
+	if txm.refreshesBalances() {
+		txm.refreshBalances()
+	}
+	txm.startHeadWork()
 
 	// Upon connecting/reconnecting, rebroadcast any transactions that are still unconfirmed
-	attempts, err := txm.orm.UnconfirmedTxAttempts()
+	attempts, err := txm.orm.UnconfirmedTxAttempts(txm.ChainID)
 	if err != nil {
 		merr = multierr.Append(merr, err)
 		return merr
@@ -185,29 +243,368 @@ func (txm *EthTxManager) Connect(bn *models.Head) error {
 // Disconnect marks this instance as disconnected.
 func (txm *EthTxManager) Disconnect() {
 	txm.connected.UnSet()
+	txm.stopHeadWork()
 }
 
-// OnNewHead does nothing; exists to comply with interface.
+// OnNewHead records the new head, and wakes the background work that
+// refreshes the balances used to select keys, confirms again the
+// transactions of orphaned blocks that have no run to do so, and fills the
+// nonce gaps that have persisted for ETH_NONCE_GAP_REPAIR_THRESHOLD heads.
 func (txm *EthTxManager) OnNewHead(head *models.Head) {
 	txm.currentHead = *head
+	txm.resetFinalizedHeights()
+	if txm.hasHeadWork() && txm.Connected() {
+		// Only the latest head is worth handling, so replace any head the
+		// background work has not picked up yet.
+		select {
+		case <-txm.chHeadWork:
+		default:
+		}
+		select {
+		case txm.chHeadWork <- *head:
+		default:
+		}
+	}
+}
+
+// hasHeadWork returns true if there is work to do in the background on each
+// head.
+func (txm *EthTxManager) hasHeadWork() bool {
+	txm.reorgedMutex.Lock()
+	reorged := len(txm.reorgedTxIDs) > 0
+	txm.reorgedMutex.Unlock()
+	return reorged || txm.config.EthNonceGapRepairThreshold() > 0 || txm.refreshesBalances()
+}
+
+// startHeadWork starts handling new heads in the background, so that the
+// calls to the node to refresh balances, confirm transactions and repair
+// nonce gaps do not hold up the head tracker.
+func (txm *EthTxManager) startHeadWork() {
+	txm.headWorkMutex.Lock()
+	defer txm.headWorkMutex.Unlock()
+
+	if txm.chStopHeadWork != nil {
+		return
+	}
+	txm.chStopHeadWork = make(chan struct{})
+	go txm.headWorkLoop(txm.chStopHeadWork)
+}
+
+// stopHeadWork stops the background work once it is done with the current
+// head.
+func (txm *EthTxManager) stopHeadWork() {
+	txm.headWorkMutex.Lock()
+	defer txm.headWorkMutex.Unlock()
+
+	if txm.chStopHeadWork == nil {
+		return
+	}
+	close(txm.chStopHeadWork)
+	txm.chStopHeadWork = nil
+}
+
+func (txm *EthTxManager) headWorkLoop(chStop chan struct{}) {
+	for {
+		select {
+		case head := <-txm.chHeadWork:
+			if txm.refreshesBalances() {
+				txm.refreshBalances()
+			}
+			txm.confirmReorgedTxs()
+			if txm.config.EthNonceGapRepairThreshold() > 0 {
+				txm.repairNonceGaps(&head)
+			}
+		case <-chStop:
+			return
+		}
+	}
+}
+
+// OnReorg records the new head, and forgets the nonce gaps seen since the
+// common ancestor, since they were seen on blocks that are no longer part of
+// the chain. Transactions that were safe in an orphaned block are unconfirmed,
+// as they may not be part of the new chain, and confirmed again on each head
+// until they are safe: the runs that sent them are reopened to do so, and the
+// tx manager checks those without a run itself.
+func (txm *EthTxManager) OnReorg(ancestor *models.Head, head *models.Head) {
+	txm.currentHead = *head
+	txm.resetFinalizedHeights()
+
+	txm.accountsMutex.Lock()
+	for _, ma := range txm.availableAccounts {
+		ma.resetNonceGapSince(ancestor.Number)
+	}
+	txm.accountsMutex.Unlock()
+
+	txs, err := txm.orm.UnconfirmTxsAfter(txm.ChainID, ancestor.Number)
+	if err != nil {
+		logger.Errorw("Unable to unconfirm txs mined in orphaned blocks", "ancestor", ancestor.Number, "error", err)
+		return
+	}
+	for i := range txs {
+		tx := &txs[i]
+		logger.Warnw("Tx was mined in a block orphaned by a reorg, confirming it again", "txID", tx.ID, "nonce", tx.Nonce, "ancestor", ancestor.Number)
+		reopened, err := txm.reopenJobRun(tx)
+		if err != nil {
+			logger.Errorw("Unable to reopen the run of tx mined in an orphaned block", "txID", tx.ID, "jobRunID", tx.SurrogateID.ValueOrZero(), "error", err)
+		}
+		if !reopened {
+			txm.reorgedMutex.Lock()
+			txm.reorgedTxIDs[tx.ID] = struct{}{}
+			txm.reorgedMutex.Unlock()
+		}
+	}
+}
+
+// reopenJobRun moves the run that sent the transaction back to pending
+// confirmations, so that it confirms the transaction again on each head. It
+// returns false if the transaction has no run that can be reopened.
+func (txm *EthTxManager) reopenJobRun(tx *models.Tx) (bool, error) {
+	if !tx.SurrogateID.Valid {
+		return false, nil
+	}
+	runID, err := models.NewIDFromString(tx.SurrogateID.String)
+	if err != nil {
+		return false, nil
+	}
+	run, err := txm.orm.Unscoped().FindJobRun(runID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrap(err, "reopenJobRun FindJobRun")
+	}
+
+	if reopened, err := run.ReopenForReorg(tx); err != nil || !reopened {
+		return false, err
+	}
+	logger.Warnw("Reopening run whose tx was mined in an orphaned block", run.ForLogger("txID", tx.ID)...)
+	return true, txm.orm.SaveJobRun(&run)
+}
+
+// confirmReorgedTxs checks the transactions that were mined in orphaned
+// blocks and have no run to confirm them, until they are safe once more.
+func (txm *EthTxManager) confirmReorgedTxs() {
+	txm.reorgedMutex.Lock()
+	ids := make([]uint64, 0, len(txm.reorgedTxIDs))
+	for id := range txm.reorgedTxIDs {
+		ids = append(ids, id)
+	}
+	txm.reorgedMutex.Unlock()
+
+	for _, id := range ids {
+		tx, err := txm.orm.FindTx(id)
+		if err == nil && !tx.Confirmed {
+			var state AttemptState
+			_, state, err = txm.checkChainForConfirmation(tx)
+			if state != Safe {
+				if err != nil {
+					logger.Warnw("Unable to confirm tx mined in an orphaned block", "txID", id, "error", err)
+				}
+				continue
+			}
+		} else if err != nil && errors.Cause(err) != orm.ErrorNotFound {
+			logger.Warnw("Unable to find tx mined in an orphaned block", "txID", id, "error", err)
+			continue
+		}
+
+		txm.reorgedMutex.Lock()
+		delete(txm.reorgedTxIDs, id)
+		txm.reorgedMutex.Unlock()
+	}
+}
+
+// refreshesBalances returns true if key selection depends on ETH balances
+// that the balance monitor does not supply, as it is disabled or only runs on
+// the primary chain.
+func (txm *EthTxManager) refreshesBalances() bool {
+	balanceBased := txm.config.EthKeySelectionPolicy() == orm.KeySelectionHighestBalance ||
+		!txm.minKeyBalance().IsZero()
+	return balanceBased && (!txm.config.BalanceMonitorEnabled() || txm.ChainID != nil)
+}
+
+// refreshBalances fetches the ETH balance of each account. An account whose
+// balance cannot be fetched keeps the last one seen.
+func (txm *EthTxManager) refreshBalances() {
+	txm.accountsMutex.Lock()
+	available := make([]*ManagedAccount, len(txm.availableAccounts))
+	copy(available, txm.availableAccounts)
+	txm.accountsMutex.Unlock()
+
+	min := txm.minKeyBalance()
+	for _, ma := range available {
+		balance, err := txm.GetEthBalance(ma.Address)
+		if err != nil {
+			logger.Warnw("Unable to fetch account balance", "address", ma.Address.Hex(), "error", err)
+			continue
+		}
+		wasFunded := ma.hasBalanceOf(min)
+		ma.SetBalance(balance)
+		if wasFunded && !ma.hasBalanceOf(min) {
+			logger.Warnw("Account balance is below ETH_KEY_MIN_BALANCE, no longer selecting it for transactions",
+				"address", ma.Address.Hex(), "balance", balance, "minBalance", min)
+		} else if !wasFunded && ma.hasBalanceOf(min) {
+			logger.Infow("Account balance is above ETH_KEY_MIN_BALANCE, selecting it for transactions again",
+				"address", ma.Address.Hex(), "balance", balance)
+		}
+	}
+}
+
+// repairNonceGaps compares each account's local nonce with the nonce the node
+// expects next, counting pending transactions. If the node expects a lower
+// nonce, transactions were dropped or never reached it, and every later
+// transaction is stuck until the gap is filled.
+func (txm *EthTxManager) repairNonceGaps(head *models.Head) {
+	threshold := int64(txm.config.EthNonceGapRepairThreshold())
+
+	txm.accountsMutex.Lock()
+	available := make([]*ManagedAccount, len(txm.availableAccounts))
+	copy(available, txm.availableAccounts)
+	txm.accountsMutex.Unlock()
+
+	for _, ma := range available {
+		pending, err := txm.GetNonce(ma.Address)
+		if err != nil {
+			logger.Warnw("Unable to check account for nonce gaps", "address", ma.Address.Hex(), "error", err)
+			continue
+		}
+		gap, ok := ma.observeNonceGap(pending, head.Number)
+		if !ok || head.Number-gap.seenAt < threshold {
+			continue
+		}
+
+		logger.Warnw("Filling nonce gap", "address", ma.Address.Hex(), "from", gap.start, "to", gap.end-1, "seenAt", gap.seenAt)
+		for nonce := gap.start; nonce < gap.end; nonce++ {
+			if err := txm.fillNonce(ma, nonce, uint64(head.Number)); err != nil {
+				logger.Errorw("Unable to fill nonce gap", "address", ma.Address.Hex(), "nonce", nonce, "error", err)
+				break
+			}
+			promNonceGapsFilled.Inc()
+		}
+		ma.resetNonceGap()
+	}
+}
+
+// fillNonce rebroadcasts the transaction recorded for the nonce, or sends a
+// zero value transaction to the account itself if there is none.
+func (txm *EthTxManager) fillNonce(ma *ManagedAccount, nonce uint64, blockHeight uint64) error {
+	tx, err := txm.orm.FindTxByNonce(txm.ChainID, ma.Address, nonce)
+	if err == nil {
+		if tx.Confirmed || len(tx.Attempts) == 0 {
+			return nil
+		}
+		attempt := tx.Attempts[len(tx.Attempts)-1]
+		logger.Infow("Rebroadcasting tx to fill nonce gap", "txHash", attempt.Hash.Hex(), "nonce", nonce)
+		_, err = txm.SendRawTx(attempt.SignedRawTx)
+		if isNonceTooLowError(err) {
+			return nil
+		}
+		return err
+	} else if errors.Cause(err) != orm.ErrorNotFound {
+		return errors.Wrap(err, "fillNonce FindTxByNonce")
+	}
+
+	tx, err = txm.newTx(
+		ma.Account,
+		nonce,
+		ma.Address,
+		big.NewInt(0),
+		cancelTxGasLimit,
+		txm.initialFees(txm.config.EthGasPriceDefault()),
+		[]byte{},
+		&ma.Address,
+		blockHeight,
+	)
+	if err != nil {
+		return errors.Wrap(err, "fillNonce newTx")
+	}
+	tx, err = txm.orm.CreateTx(tx)
+	if err != nil {
+		return errors.Wrap(err, "fillNonce CreateTx")
+	}
+	logger.Infow("Sending zero value tx to fill nonce gap", "txHash", tx.Hash.Hex(), "nonce", nonce)
+	if _, err = txm.SendRawTx(tx.SignedRawTx); err != nil && !isNonceTooLowError(err) {
+		return errors.Wrap(err, "fillNonce SendRawTx")
+	}
+	_, err = txm.orm.AddTxAttempt(tx, tx)
+	return errors.Wrap(err, "fillNonce AddTxAttempt")
 }
 
 // CreateTx signs and sends a transaction to the Ethereum blockchain.
 func (txm *EthTxManager) CreateTx(to common.Address, data []byte) (*models.Tx, error) {
-	return txm.CreateTxWithGas(null.String{}, to, data, txm.config.EthGasPriceDefault(), txm.config.EthGasLimitDefault())
+	return txm.CreateTxWithGas(null.String{}, to, data, txm.config.EthGasPriceDefault(), 0)
 }
 
-// CreateTxWithGas signs and sends a transaction to the Ethereum blockchain.
+// CreateTxWithGas signs and sends a transaction to the Ethereum blockchain,
+// from the key chosen by ETH_KEY_SELECTION_POLICY.
 func (txm *EthTxManager) CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
 	ma, err := txm.nextAccount()
 	if err != nil {
 		return nil, err
 	}
+	return txm.createTxWithGas(surrogateID, ma, to, data, gasPriceWei, gasLimit)
+}
+
+// CreateTxFrom signs and sends a transaction to the Ethereum blockchain from
+// the given key. It returns ErrUnknownAccount if the key is not one of the
+// node's, and ErrKeyBelowMinimumBalance if its balance is too low.
+func (txm *EthTxManager) CreateTxFrom(surrogateID null.String, from, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
+	if !txm.Connected() {
+		return nil, errors.Wrap(ErrPendingConnection, "EthTxManager#CreateTxFrom")
+	}
 
+	ma := txm.getAccount(from)
+	if ma == nil {
+		return nil, errors.Wrapf(ErrUnknownAccount, "EthTxManager#CreateTxFrom %s", from.Hex())
+	} else if !ma.hasBalanceOf(txm.minKeyBalance()) {
+		return nil, errors.Wrapf(ErrKeyBelowMinimumBalance, "EthTxManager#CreateTxFrom %s", from.Hex())
+	}
+	return txm.createTxWithGas(surrogateID, ma, to, data, gasPriceWei, gasLimit)
+}
+
+func (txm *EthTxManager) createTxWithGas(surrogateID null.String, ma *ManagedAccount, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
+	var err error
+
+	// Outside of dev mode the job's gas limit is ignored, so it is always
+	// estimated when estimation is enabled.
+	estimate := txm.config.EthGasLimitEstimationEnabled() && (gasLimit == 0 || !txm.config.Dev())
 	gasPriceWei, gasLimit = normalizeGasParams(gasPriceWei, gasLimit, txm.config)
+	if estimate {
+		gasLimit, err = txm.estimateGasLimit(ma.Address, to, data, gasLimit)
+		if err != nil {
+			return nil, errors.Wrap(err, "TxManager#CreateTxWithGas estimateGasLimit")
+		}
+	}
 	return txm.createTx(surrogateID, ma, to, data, gasPriceWei, gasLimit, nil)
 }
 
+// estimateGasLimit returns the node's gas estimate for the transaction,
+// multiplied by ETH_GAS_LIMIT_MULTIPLIER and capped at ETH_GAS_LIMIT_MAX. The
+// fallback limit is returned if the node cannot estimate it, but a call that
+// reverts returns an *eth.RevertError, as the transaction would be doomed, and
+// an estimate above the maximum returns ErrGasLimitExceedsMax.
+func (txm *EthTxManager) estimateGasLimit(from, to common.Address, data []byte, fallback uint64) (uint64, error) {
+	estimate, err := txm.EstimateGas(from, to, data)
+	if _, ok := err.(*eth.RevertError); ok {
+		return 0, err
+	} else if err != nil {
+		logger.Warnw("Unable to estimate gas limit, using default", "to", to.Hex(), "gasLimit", fallback, "error", err)
+		return fallback, nil
+	}
+
+	max := txm.config.EthGasLimitMax()
+	if estimate > max {
+		return 0, errors.Wrapf(ErrGasLimitExceedsMax, "estimated gas limit of %v, maximum of %v", estimate, max)
+	}
+	gasLimit := uint64(float64(estimate) * txm.config.EthGasLimitMultiplier())
+	if gasLimit < estimate {
+		gasLimit = estimate
+	}
+	if gasLimit > max {
+		gasLimit = max
+	}
+	return gasLimit, nil
+}
+
 // CreateTxWithEth signs and sends a transaction with some ETH to transfer.
 func (txm *EthTxManager) CreateTxWithEth(from, to common.Address, value *assets.Eth) (*models.Tx, error) {
 	ma := txm.getAccount(from)
@@ -223,12 +620,78 @@ func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
 		return nil, errors.Wrap(ErrPendingConnection, "EthTxManager#nextAccount")
 	}
 
-	ma := txm.NextActiveAccount()
-	if ma == nil {
+	return txm.selectAccount()
+}
+
+// selectAccount chooses the account to send a transaction from according to
+// ETH_KEY_SELECTION_POLICY, skipping those with a balance below
+// ETH_KEY_MIN_BALANCE. Accounts are considered starting after the last one
+// selected, so ties are broken round robin.
+func (txm *EthTxManager) selectAccount() (*ManagedAccount, error) {
+	policy := txm.config.EthKeySelectionPolicy()
+	var pending map[common.Address]int
+	if policy == orm.KeySelectionLeastPending {
+		counts, err := txm.orm.UnconfirmedTxCounts(txm.ChainID)
+		if err != nil {
+			return nil, errors.Wrap(err, "EthTxManager#selectAccount UnconfirmedTxCounts")
+		}
+		pending = counts
+	}
+	min := txm.minKeyBalance()
+
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	count := len(txm.availableAccounts)
+	if count == 0 {
 		return nil, errors.New("Must connect and activate an account before creating a transaction")
 	}
 
-	return ma, nil
+	var selected *ManagedAccount
+	selectedIdx := 0
+	for i := 0; i < count; i++ {
+		idx := (txm.availableAccountIdx + i) % count
+		ma := txm.availableAccounts[idx]
+		if !ma.hasBalanceOf(min) {
+			continue
+		}
+		if selected == nil || preferAccount(policy, ma, selected, pending) {
+			selected, selectedIdx = ma, idx
+		}
+	}
+	if selected == nil {
+		return nil, ErrNoFundedAccounts
+	}
+
+	txm.availableAccountIdx = (selectedIdx + 1) % count
+	return selected, nil
+}
+
+// minKeyBalance returns the balance a key needs to be selected. With
+// ETH_PAUSE_EMPTY_KEYS set, keys with no ETH are skipped even if
+// ETH_KEY_MIN_BALANCE is zero.
+func (txm *EthTxManager) minKeyBalance() *assets.Eth {
+	min := txm.config.EthKeyMinBalance()
+	if min.IsZero() && txm.config.EthPauseEmptyKeys() {
+		return assets.NewEth(1)
+	}
+	return min
+}
+
+// preferAccount returns true if the policy prefers candidate over current.
+func preferAccount(policy orm.KeySelectionPolicy, candidate, current *ManagedAccount, pending map[common.Address]int) bool {
+	switch policy {
+	case orm.KeySelectionLeastPending:
+		return pending[candidate.Address] < pending[current.Address]
+	case orm.KeySelectionHighestBalance:
+		candidateBalance, currentBalance := candidate.Balance(), current.Balance()
+		if candidateBalance == nil {
+			return false
+		}
+		return currentBalance == nil || candidateBalance.Cmp(currentBalance) > 0
+	default:
+		return false
+	}
 }
 
 func normalizeGasParams(gasPriceWei *big.Int, gasLimit uint64, config orm.ConfigReader) (*big.Int, uint64) {
@@ -315,7 +778,7 @@ func (txm *EthTxManager) sendInitialTx(
 			to,
 			value.ToInt(),
 			gasLimit,
-			gasPriceWei,
+			txm.initialFees(gasPriceWei),
 			data,
 			&ma.Address,
 			blockHeight,
@@ -325,6 +788,7 @@ func (txm *EthTxManager) sendInitialTx(
 		}
 
 		tx.SurrogateID = surrogateID
+		tx.FinalityTag = txm.runFinalityTag(surrogateID)
 		tx, err = txm.orm.CreateTx(tx)
 		if err != nil {
 			return errors.Wrap(err, "TxManager#sendInitialTx CreateTx")
@@ -351,6 +815,7 @@ func (txm *EthTxManager) sendInitialTx(
 var (
 	nonceTooLowRegex                       = regexp.MustCompile("(nonce .*too low|same hash was already imported|replacement transaction underpriced)")
 	replacementTransactionUnderpricedRegex = regexp.MustCompile("replacement transaction underpriced")
+	unsupportedBlockTagRegex               = regexp.MustCompile("(?i)(hex string without 0x prefix|invalid block number|invalid block tag|unknown block tag)")
 )
 
 // FIXME: There are probably other types of errors here that are symptomatic of a nonce that is too low
@@ -362,6 +827,12 @@ func isUnderPricedReplacementError(err error) bool {
 	return err != nil && replacementTransactionUnderpricedRegex.MatchString(err.Error())
 }
 
+// isUnsupportedBlockTagError returns whether the node rejected a block tag it
+// does not know, as nodes predating the safe and finalized tags do.
+func isUnsupportedBlockTagError(err error) bool {
+	return err != nil && unsupportedBlockTagRegex.MatchString(err.Error())
+}
+
 // SignedRawTxWithBumpedGas takes a transaction and generates a new signed TX from it with the provided params
 func (txm *EthTxManager) SignedRawTxWithBumpedGas(originalTx models.Tx, gasLimit uint64, gasPrice big.Int) ([]byte, error) {
 	ma := txm.getAccount(originalTx.From)
@@ -370,6 +841,25 @@ func (txm *EthTxManager) SignedRawTxWithBumpedGas(originalTx models.Tx, gasLimit
 		return nil, fmt.Errorf("Unable to locate %v as an available account in EthTxManager. Has TxManager been started or has the address been removed?", originalTx.From.Hex())
 	}
 
+	if originalTx.GasTipCap != nil {
+		// The new gas price becomes the max fee, and the tip is bumped so that
+		// the node accepts it as a replacement
+		tipCap := txm.BumpGasByIncrement(originalTx.GasTipCap.ToInt())
+		if tipCap.Cmp(&gasPrice) > 0 {
+			tipCap = &gasPrice
+		}
+		return txm.keyStore.SignDynamicFeeTx(ma.Account, &eth.DynamicFeeTx{
+			ChainID:   txm.config.ChainID(),
+			Nonce:     originalTx.Nonce,
+			GasTipCap: tipCap,
+			GasFeeCap: &gasPrice,
+			Gas:       gasLimit,
+			To:        originalTx.To,
+			Value:     originalTx.Value.ToInt(),
+			Data:      originalTx.Data,
+		})
+	}
+
 	transaction := types.NewTransaction(originalTx.Nonce, originalTx.To, originalTx.Value.ToInt(), gasLimit, &gasPrice, originalTx.Data)
 
 	transaction, err := txm.keyStore.SignTx(ma.Account, transaction, txm.config.ChainID())
@@ -383,6 +873,48 @@ func (txm *EthTxManager) SignedRawTxWithBumpedGas(originalTx models.Tx, gasLimit
 	return rlp.Bytes(), nil
 }
 
+// txFees holds what a transaction attempt pays for gas. Legacy transactions
+// only set GasPrice, while EIP-1559 transactions set GasTipCap and GasFeeCap.
+type txFees struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+func (f txFees) dynamic() bool {
+	return f.GasTipCap != nil
+}
+
+func (f txFees) String() string {
+	if f.dynamic() {
+		return fmt.Sprintf("tip cap %v, fee cap %v", f.GasTipCap, f.GasFeeCap)
+	}
+	return f.GasPrice.String()
+}
+
+// initialFees returns the fees of the first attempt of a transaction. When
+// EIP-1559 is enabled the configured defaults, kept up to date by the
+// GasUpdater, are used and the gas price is ignored.
+func (txm *EthTxManager) initialFees(gasPriceWei *big.Int) txFees {
+	if !txm.config.EthEIP1559() {
+		return txFees{GasPrice: gasPriceWei}
+	}
+	feeCap := txm.config.EthGasFeeCapDefault()
+	tipCap := txm.config.EthGasTipCapDefault()
+	if tipCap.Cmp(feeCap) > 0 {
+		tipCap = feeCap
+	}
+	return txFees{GasTipCap: tipCap, GasFeeCap: feeCap}
+}
+
+// attemptFees returns the fees paid by an existing attempt.
+func attemptFees(txAttempt *models.TxAttempt) txFees {
+	if txAttempt.GasTipCap != nil && txAttempt.GasFeeCap != nil {
+		return txFees{GasTipCap: txAttempt.GasTipCap.ToInt(), GasFeeCap: txAttempt.GasFeeCap.ToInt()}
+	}
+	return txFees{GasPrice: txAttempt.GasPrice.ToInt()}
+}
+
 // newTx returns a newly signed Ethereum Transaction
 func (txm *EthTxManager) newTx(
 	account accounts.Account,
@@ -390,12 +922,16 @@ func (txm *EthTxManager) newTx(
 	to common.Address,
 	amount *big.Int,
 	gasLimit uint64,
-	gasPrice *big.Int,
+	fees txFees,
 	data []byte,
 	from *common.Address,
 	sentAt uint64) (*models.Tx, error) {
 
-	transaction := types.NewTransaction(nonce, to, amount, gasLimit, gasPrice, data)
+	if fees.dynamic() {
+		return txm.newDynamicFeeTx(account, nonce, to, amount, gasLimit, fees, data, from, sentAt)
+	}
+
+	transaction := types.NewTransaction(nonce, to, amount, gasLimit, fees.GasPrice, data)
 
 	transaction, err := txm.keyStore.SignTx(account, transaction, txm.config.ChainID())
 	if err != nil {
@@ -415,12 +951,60 @@ func (txm *EthTxManager) newTx(
 		Data:        transaction.Data(),
 		Value:       utils.NewBig(transaction.Value()),
 		GasLimit:    transaction.Gas(),
+		ChainID:     txm.ChainID,
 		GasPrice:    utils.NewBig(transaction.GasPrice()),
 		Hash:        transaction.Hash(),
 		SignedRawTx: rlp.Bytes(),
 	}, nil
 }
 
+// newDynamicFeeTx returns a newly signed EIP-1559 transaction. Its GasPrice
+// is the max fee, the most it can cost per gas.
+func (txm *EthTxManager) newDynamicFeeTx(
+	account accounts.Account,
+	nonce uint64,
+	to common.Address,
+	amount *big.Int,
+	gasLimit uint64,
+	fees txFees,
+	data []byte,
+	from *common.Address,
+	sentAt uint64) (*models.Tx, error) {
+
+	if amount == nil {
+		amount = new(big.Int)
+	}
+	signedRawTx, err := txm.keyStore.SignDynamicFeeTx(account, &eth.DynamicFeeTx{
+		ChainID:   txm.config.ChainID(),
+		Nonce:     nonce,
+		GasTipCap: fees.GasTipCap,
+		GasFeeCap: fees.GasFeeCap,
+		Gas:       gasLimit,
+		To:        to,
+		Value:     amount,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "TxManager newDynamicFeeTx.SignDynamicFeeTx")
+	}
+
+	return &models.Tx{
+		From:        *from,
+		SentAt:      sentAt,
+		To:          to,
+		Nonce:       nonce,
+		Data:        data,
+		Value:       utils.NewBig(amount),
+		GasLimit:    gasLimit,
+		ChainID:     txm.ChainID,
+		GasPrice:    utils.NewBig(fees.GasFeeCap),
+		GasTipCap:   utils.NewBig(fees.GasTipCap),
+		GasFeeCap:   utils.NewBig(fees.GasFeeCap),
+		Hash:        crypto.Keccak256Hash(signedRawTx),
+		SignedRawTx: signedRawTx,
+	}, nil
+}
+
 // GetLINKBalance returns the balance of LINK at the given address
 func (txm *EthTxManager) GetLINKBalance(address common.Address) (*assets.Link, error) {
 	contractAddress := common.HexToAddress(txm.config.LinkContractAddress())
@@ -447,6 +1031,44 @@ func (txm *EthTxManager) BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, Att
 	return txm.checkAccountForConfirmation(tx)
 }
 
+// CancelTx replaces an unconfirmed transaction with a zero value transaction
+// from its sender to itself, at the same nonce and a bumped gas price, so
+// that the nonce is used up without performing the original call. The
+// replacement is recorded as a cancellation attempt of the Tx, which keeps
+// its original fields, and later gas bumps send further cancellations.
+func (txm *EthTxManager) CancelTx(hash common.Hash) (*models.Tx, error) {
+	tx, _, err := txm.orm.FindTxByAttempt(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "CancelTx FindTxByAttempt")
+	} else if !sameChain(tx.ChainID, txm.ChainID) {
+		return nil, errors.Wrapf(ErrTxOnOtherChain, "CancelTx chain %s", tx.ChainID)
+	} else if tx.Confirmed {
+		return nil, ErrTxAlreadyConfirmed
+	}
+
+	fees := txm.bumpFees(attemptFees(tx.Attempts[len(tx.Attempts)-1]))
+	if err := txm.checkMaxGasPrice(fees); err != nil {
+		return nil, err
+	}
+
+	txAttempt, err := txm.sendAttempt(tx, fees, uint64(txm.currentHead.Number), true)
+	if err != nil {
+		return nil, errors.Wrap(err, "CancelTx createAttempt")
+	}
+
+	logger.Infow("Cancelling tx", "originalTxHash", hash.Hex(), "newTxHash", txAttempt.Hash.Hex(), "nonce", tx.Nonce, "gasPrice", fees)
+	return tx, nil
+}
+
+// sameChain returns whether the chain IDs are the same, nil being the primary
+// chain.
+func sameChain(a, b *utils.Big) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.ToInt().Cmp(b.ToInt()) == 0
+}
+
 func (txm *EthTxManager) checkChainForConfirmation(tx *models.Tx) (*eth.TxReceipt, AttemptState, error) {
 	blockHeight := uint64(txm.currentHead.Number)
 
@@ -481,12 +1103,7 @@ func (txm *EthTxManager) checkAccountForConfirmation(tx *models.Tx) (*eth.TxRece
 
 // GetAvailableAccount retrieves a managed account if it one matches the address given.
 func (txm *EthTxManager) GetAvailableAccount(from common.Address) *ManagedAccount {
-	for _, a := range txm.availableAccounts {
-		if a.Address == from {
-			return a
-		}
-	}
-	return nil
+	return txm.getAccount(from)
 }
 
 // ContractLINKBalance returns the balance for the contract associated with this
@@ -543,8 +1160,20 @@ func (txm *EthTxManager) WithdrawLINK(wr models.WithdrawalRequest) (common.Hash,
 }
 
 // CheckAttempt retrieves a receipt for a TxAttempt, and check if it meets the
-// minimum number of confirmations
+// minimum number of confirmations, or is final if the transaction, or
+// ETH_FINALITY_TAG, sets a finality tag
 func (txm *EthTxManager) CheckAttempt(txAttempt *models.TxAttempt, blockHeight uint64) (*eth.TxReceipt, AttemptState, error) {
+	tx := txAttempt.Tx
+	if tx == nil {
+		var err error
+		if tx, err = txm.orm.FindTx(txAttempt.TxID); err != nil {
+			return nil, Unknown, errors.Wrap(err, "CheckAttempt FindTx failed")
+		}
+	}
+	return txm.checkAttempt(txAttempt, txm.finalityTag(tx), blockHeight)
+}
+
+func (txm *EthTxManager) checkAttempt(txAttempt *models.TxAttempt, tag models.FinalityTag, blockHeight uint64) (*eth.TxReceipt, AttemptState, error) {
 	receipt, err := txm.GetTxReceipt(txAttempt.Hash)
 	if err != nil {
 		return nil, Unknown, errors.Wrap(err, "CheckAttempt GetTxReceipt failed")
@@ -554,18 +1183,126 @@ func (txm *EthTxManager) CheckAttempt(txAttempt *models.TxAttempt, blockHeight u
 		return receipt, Unconfirmed, nil
 	}
 
-	minimumConfirmations := new(big.Int).SetUint64(txm.config.MinOutgoingConfirmations())
-	confirmedAt := new(big.Int).Add(minimumConfirmations, receipt.BlockNumber.ToInt())
+	if finalized, err := txm.FinalizedHeight(tag); err != nil {
+		logger.Warnw("Unable to check whether the attempt is final, waiting for the next head", "txHash", txAttempt.Hash.Hex(), "error", err)
+		return receipt, Confirmed, nil
+	} else if finalized != nil {
+		if receipt.BlockNumber.ToInt().Cmp(finalized) > 0 {
+			return receipt, Confirmed, nil
+		}
+	} else {
+		minimumConfirmations := new(big.Int).SetUint64(txm.config.MinOutgoingConfirmations())
+		confirmedAt := new(big.Int).Add(minimumConfirmations, receipt.BlockNumber.ToInt())
 
-	confirmedAt.Sub(confirmedAt, big.NewInt(1)) // confirmed at block counts as 1 conf
+		confirmedAt.Sub(confirmedAt, big.NewInt(1)) // confirmed at block counts as 1 conf
 
-	if new(big.Int).SetUint64(blockHeight).Cmp(confirmedAt) == -1 {
-		return receipt, Confirmed, nil
+		if new(big.Int).SetUint64(blockHeight).Cmp(confirmedAt) == -1 {
+			return receipt, Confirmed, nil
+		}
 	}
 
+	if receipt.Failed() {
+		txm.recordRevertReason(txAttempt, receipt)
+	}
 	return receipt, Safe, nil
 }
 
+// finalityTag returns the block tag the transaction waits for to be final,
+// ETH_FINALITY_TAG of the chain unless the job that sent it sets its own.
+func (txm *EthTxManager) finalityTag(tx *models.Tx) models.FinalityTag {
+	if tx.FinalityTag != "" {
+		return tx.FinalityTag
+	}
+	return txm.config.EthFinalityTag()
+}
+
+// runFinalityTag returns the finality tag of the job whose run has the
+// surrogate ID, empty if there is no such run or its job doesn't set one.
+func (txm *EthTxManager) runFinalityTag(surrogateID null.String) models.FinalityTag {
+	if !surrogateID.Valid {
+		return ""
+	}
+	runID, err := models.NewIDFromString(surrogateID.String)
+	if err != nil {
+		return ""
+	}
+	tag, err := txm.orm.FindJobRunFinalityTag(runID)
+	if err != nil && errors.Cause(err) != orm.ErrorNotFound {
+		logger.Warnw("Unable to find the finality tag of the job run, using ETH_FINALITY_TAG", "jobRunID", surrogateID.String, "error", err)
+	}
+	return tag
+}
+
+// FinalizedHeight returns the number of the block at the finality tag, or nil
+// if confirmations are counted instead. That is the case for the latest tag,
+// and for nodes that don't support the tag, such as those of chains without a
+// beacon chain. Any other error means the height is unknown, and nothing
+// should be considered final until it is. Heights are fetched once per head.
+func (txm *EthTxManager) FinalizedHeight(tag models.FinalityTag) (*big.Int, error) {
+	if tag == "" || tag == models.FinalityTagLatest {
+		return nil, nil
+	}
+
+	txm.finalizedMutex.Lock()
+	defer txm.finalizedMutex.Unlock()
+	if height, ok := txm.finalizedHeights[tag]; ok {
+		return height, nil
+	}
+
+	var header *eth.BlockHeader
+	err := txm.Call(&header, "eth_getBlockByNumber", string(tag), false)
+	if isUnsupportedBlockTagError(err) || (err == nil && header == nil) {
+		logger.Warnw(fmt.Sprintf("Ethereum node does not support the %s block tag, counting confirmations instead", tag), "error", err)
+		txm.finalizedHeights[tag] = nil
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "FinalizedHeight eth_getBlockByNumber %s", tag)
+	}
+
+	height := header.Number.ToInt()
+	txm.finalizedHeights[tag] = height
+	return height, nil
+}
+
+// resetFinalizedHeights forgets the heights fetched at the previous head.
+func (txm *EthTxManager) resetFinalizedHeights() {
+	txm.finalizedMutex.Lock()
+	defer txm.finalizedMutex.Unlock()
+	txm.finalizedHeights = make(map[models.FinalityTag]*big.Int)
+}
+
+// recordRevertReason replays a mined transaction that reverted on top of the
+// block it was mined in, and saves the reason it reverted with on the Tx.
+// Failing to find the reason only affects reporting, so errors are logged.
+func (txm *EthTxManager) recordRevertReason(txAttempt *models.TxAttempt, receipt *eth.TxReceipt) {
+	tx, err := txm.orm.FindTx(txAttempt.TxID)
+	if err != nil {
+		logger.Warnw("Unable to find reverted transaction", "txHash", txAttempt.Hash.Hex(), "error", err)
+		return
+	} else if tx.RevertReason.Valid {
+		return
+	}
+
+	gas := hexutil.Uint64(tx.GasLimit)
+	args := eth.CallArgs{
+		From:  &tx.From,
+		To:    tx.To,
+		Gas:   &gas,
+		Value: (*hexutil.Big)(tx.Value.ToInt()),
+		Data:  tx.Data,
+	}
+	reason, err := txm.GetRevertReason(args, receipt.BlockNumber.ToInt())
+	if err != nil {
+		logger.Warnw("Unable to replay reverted transaction", "txHash", txAttempt.Hash.Hex(), "error", err)
+		return
+	}
+
+	logger.Warnw("Tx reverted", "txHash", txAttempt.Hash.Hex(), "txID", tx.ID, "revertReason", reason, "jobRunId", tx.SurrogateID.ValueOrZero())
+	if err := txm.orm.SetTxRevertReason(tx, reason); err != nil {
+		logger.Errorw("Unable to save revert reason", "txHash", txAttempt.Hash.Hex(), "error", err)
+	}
+}
+
 // AttemptState enumerates the possible states of a transaction attempt as it
 // gets accepted and confirmed by the blockchain
 type AttemptState int
@@ -609,12 +1346,12 @@ func (txm *EthTxManager) processAttempt(
 	jobRunID := tx.SurrogateID.ValueOrZero()
 	txAttempt := tx.Attempts[attemptIndex]
 
-	receipt, state, err := txm.CheckAttempt(txAttempt, blockHeight)
+	receipt, state, err := txm.checkAttempt(txAttempt, txm.finalityTag(tx), blockHeight)
 
 	switch state {
 	case Safe:
 		txm.updateLastSafeNonce(tx)
-		return receipt, state, txm.handleSafe(tx, attemptIndex)
+		return receipt, state, txm.handleSafe(tx, attemptIndex, receipt)
 
 	case Confirmed:
 		logger.Debugw(
@@ -634,7 +1371,7 @@ func (txm *EthTxManager) processAttempt(
 		if e != nil {
 			return receipt, state, errors.Wrap(e, "confirming confirmation attempt")
 		}
-		promUpdateEthBalance(ethBalance, tx.From)
+		PromUpdateEthBalance(ethBalance, tx.From)
 		return receipt, state, nil
 
 	case Unconfirmed:
@@ -712,10 +1449,15 @@ func isLatestAttempt(tx *models.Tx, attemptIndex int) bool {
 }
 
 // handleSafe marks a transaction as safe, no more work needs to be done
+// unless a reorg orphans the block of its receipt
 func (txm *EthTxManager) handleSafe(
 	tx *models.Tx,
-	attemptIndex int) error {
+	attemptIndex int,
+	receipt *eth.TxReceipt) error {
 	txAttempt := tx.Attempts[attemptIndex]
+	if receipt.BlockNumber != nil {
+		txAttempt.ReceiptBlockNumber = null.IntFrom(receipt.BlockNumber.ToInt().Int64())
+	}
 
 	if err := txm.orm.MarkTxSafe(tx, txAttempt); err != nil {
 		return errors.Wrap(err, "handleSafe MarkTxSafe failed")
@@ -767,30 +1509,29 @@ func (txm *EthTxManager) BumpGasByIncrement(originalGasPrice *big.Int) *big.Int
 func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight uint64) error {
 	txAttempt := tx.Attempts[attemptIndex]
 
-	originalGasPrice := txAttempt.GasPrice.ToInt()
+	originalFees := attemptFees(txAttempt)
 
-	bumpedGasPrice := txm.BumpGasByIncrement(originalGasPrice)
+	bumpedFees := txm.bumpFees(originalFees)
 
 	for {
 		promNumGasBumps.Inc()
-		if bumpedGasPrice.Cmp(txm.config.EthMaxGasPriceWei()) > 0 {
+		if err := txm.checkMaxGasPrice(bumpedFees); err != nil {
 			// NOTE: In the current design, a new tx attempt will be created even if this one returns error.
 			// If we do hit this scenario, we will keep creating new attempts that are guaranteed to fail
 			// until CHAINLINK_TX_ATTEMPT_LIMIT is reached
 			promGasBumpExceedsLimit.Inc()
-			err := fmt.Errorf("bumped gas price of %v would exceed maximum configured limit of %v, set by ETH_MAX_GAS_PRICE_WEI", bumpedGasPrice, txm.config.EthMaxGasPriceWei())
 			logger.Error(err)
 			return err
 		}
-		bumpedTxAttempt, err := txm.createAttempt(tx, bumpedGasPrice, blockHeight)
+		bumpedTxAttempt, err := txm.createAttempt(tx, bumpedFees, blockHeight)
 		if isUnderPricedReplacementError(err) {
 			// This is not expected if we have bumped at least geth's required
 			// amount.
 			promGasBumpUnderpricedReplacement.Inc()
 			logger.Warnw(fmt.Sprintf("Gas bump was rejected by ethereum node as underpriced, bumping again. Your value of ETH_GAS_BUMP_PERCENT (%v) may be set too low", txm.config.EthGasBumpPercent()),
-				"originalGasPrice", originalGasPrice, "bumpedGasPrice", bumpedGasPrice,
+				"originalGasPrice", originalFees, "bumpedGasPrice", bumpedFees,
 			)
-			bumpedGasPrice = txm.BumpGasByIncrement(bumpedGasPrice)
+			bumpedFees = txm.bumpFees(bumpedFees)
 			continue
 		}
 		if err != nil {
@@ -801,7 +1542,7 @@ func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight ui
 		}
 
 		logger.Infow(
-			fmt.Sprintf("Tx #%d created with bumped gas %v", attemptIndex+1, bumpedGasPrice),
+			fmt.Sprintf("Tx #%d created with bumped gas %v", attemptIndex+1, bumpedFees),
 			"originalTxHash", txAttempt.Hash,
 			"newTxHash", bumpedTxAttempt.Hash)
 
@@ -809,25 +1550,76 @@ func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight ui
 	}
 }
 
+// bumpFees returns the fees for a replacement of an attempt paying the given
+// fees. Nodes only accept a replacement EIP-1559 transaction if both its tip
+// and fee caps are raised by the minimum bump, so both are bumped. The fee cap
+// is also raised to the current default if the base fee has since risen past
+// it.
+func (txm *EthTxManager) bumpFees(fees txFees) txFees {
+	if !fees.dynamic() {
+		return txFees{GasPrice: txm.BumpGasByIncrement(fees.GasPrice)}
+	}
+
+	tipCap := txm.BumpGasByIncrement(fees.GasTipCap)
+	feeCap := txm.BumpGasByIncrement(fees.GasFeeCap)
+	if current := txm.config.EthGasFeeCapDefault(); current.Cmp(feeCap) > 0 {
+		feeCap = current
+	}
+	if tipCap.Cmp(feeCap) > 0 {
+		feeCap = tipCap
+	}
+	return txFees{GasTipCap: tipCap, GasFeeCap: feeCap}
+}
+
+// checkMaxGasPrice returns an error if the fees could cost more per gas than
+// ETH_MAX_GAS_PRICE_WEI allows.
+func (txm *EthTxManager) checkMaxGasPrice(fees txFees) error {
+	price := fees.GasPrice
+	if fees.dynamic() {
+		price = fees.GasFeeCap
+	}
+	if price.Cmp(txm.config.EthMaxGasPriceWei()) > 0 {
+		return fmt.Errorf("bumped gas price of %v would exceed maximum configured limit of %v, set by ETH_MAX_GAS_PRICE_WEI", price, txm.config.EthMaxGasPriceWei())
+	}
+	return nil
+}
+
 // createAttempt adds a new transaction attempt to a transaction record
 func (txm *EthTxManager) createAttempt(
 	tx *models.Tx,
-	gasPriceWei *big.Int,
+	fees txFees,
+	blockHeight uint64,
+) (*models.TxAttempt, error) {
+	return txm.sendAttempt(tx, fees, blockHeight, tx.Cancelled())
+}
+
+// sendAttempt sends a new attempt of the transaction at the given fees. A
+// cancellation attempt is a zero value transaction from the sender to itself
+// in place of the original call.
+func (txm *EthTxManager) sendAttempt(
+	tx *models.Tx,
+	fees txFees,
 	blockHeight uint64,
+	cancellation bool,
 ) (*models.TxAttempt, error) {
 	ma := txm.getAccount(tx.From)
 	if ma == nil {
 		return nil, fmt.Errorf("Unable to locate %v as an available account in EthTxManager. Has TxManager been started or has the address been removed?", tx.From.Hex())
 	}
 
+	to, value, gasLimit, data := tx.To, tx.Value.ToInt(), tx.GasLimit, tx.Data
+	if cancellation {
+		to, value, gasLimit, data = tx.From, big.NewInt(0), cancelTxGasLimit, []byte{}
+	}
+
 	newTxAttempt, err := txm.newTx(
 		ma.Account,
 		tx.Nonce,
-		tx.To,
-		tx.Value.ToInt(),
-		tx.GasLimit,
-		gasPriceWei,
-		tx.Data,
+		to,
+		value,
+		gasLimit,
+		fees,
+		data,
 		&ma.Address,
 		blockHeight,
 	)
@@ -839,7 +1631,11 @@ func (txm *EthTxManager) createAttempt(
 		return nil, errors.Wrap(err, "createAttempt#SendRawTx failed")
 	}
 
-	txAttempt, err := txm.orm.AddTxAttempt(tx, newTxAttempt)
+	addTxAttempt := txm.orm.AddTxAttempt
+	if cancellation {
+		addTxAttempt = txm.orm.AddCancellationTxAttempt
+	}
+	txAttempt, err := addTxAttempt(tx, newTxAttempt)
 	if err != nil {
 		return nil, errors.Wrap(err, "createAttempt#AddTxAttempt failed")
 	}
@@ -894,9 +1690,20 @@ type ManagedAccount struct {
 	accounts.Account
 	nonce         uint64
 	lastSafeNonce uint64
+	nonceGap      *nonceGap
+	balance       *assets.Eth
+	balanceMutex  sync.RWMutex
 	mutex         *sync.Mutex
 }
 
+// nonceGap is a range of nonces below the local nonce that the node has not
+// seen a transaction for.
+type nonceGap struct {
+	start  uint64
+	end    uint64
+	seenAt int64
+}
+
 // NewManagedAccount creates a managed account that handles nonce increments
 // locally.
 func NewManagedAccount(a accounts.Account, nonce uint64) *ManagedAccount {
@@ -908,6 +1715,29 @@ func (a *ManagedAccount) Nonce() uint64 {
 	return a.nonce
 }
 
+// Balance returns the ETH balance last recorded for the account, or nil if it
+// has not been recorded.
+func (a *ManagedAccount) Balance() *assets.Eth {
+	a.balanceMutex.RLock()
+	defer a.balanceMutex.RUnlock()
+	return a.balance
+}
+
+// SetBalance records the account's ETH balance, which is used to select the
+// key for outgoing transactions.
+func (a *ManagedAccount) SetBalance(balance *assets.Eth) {
+	a.balanceMutex.Lock()
+	defer a.balanceMutex.Unlock()
+	a.balance = balance
+}
+
+// hasBalanceOf returns true if the account's balance is at least min, or has
+// not been recorded.
+func (a *ManagedAccount) hasBalanceOf(min *assets.Eth) bool {
+	balance := a.Balance()
+	return min.IsZero() || balance == nil || balance.Cmp(min) >= 0
+}
+
 // ReloadNonce fetch and update the current nonce via eth_getTransactionCount
 func (a *ManagedAccount) ReloadNonce(txm *EthTxManager) error {
 	a.mutex.Lock()
@@ -940,3 +1770,35 @@ func (a *ManagedAccount) updateLastSafeNonce(latest uint64) {
 		a.lastSafeNonce = latest
 	}
 }
+
+// observeNonceGap compares the nonce the node expects next with the local
+// nonce. It returns the gap between them, with the head it was first seen at,
+// if there is one.
+func (a *ManagedAccount) observeNonceGap(pending uint64, height int64) (nonceGap, bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if pending >= a.nonce {
+		a.nonceGap = nil
+		return nonceGap{}, false
+	}
+	if a.nonceGap == nil || a.nonceGap.start != pending {
+		a.nonceGap = &nonceGap{start: pending, seenAt: height}
+	}
+	a.nonceGap.end = a.nonce
+	return *a.nonceGap, true
+}
+
+func (a *ManagedAccount) resetNonceGapSince(height int64) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.nonceGap != nil && a.nonceGap.seenAt > height {
+		a.nonceGap = nil
+	}
+}
+
+func (a *ManagedAccount) resetNonceGap() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.nonceGap = nil
+}
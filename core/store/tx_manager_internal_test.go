@@ -1,13 +1,18 @@
 package store
 
 import (
+	"math/big"
+	"sync"
 	"testing"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func (a *ManagedAccount) PublicLastSafeNonce() uint64 {
@@ -71,3 +76,121 @@ func TestTxManager_updateLastSafeNonce_noMatchingAccount(t *testing.T) {
 
 	assert.NotEqual(t, nonce, ma.lastSafeNonce)
 }
+
+func TestTxManager_bumpFees(t *testing.T) {
+	t.Parallel()
+
+	config := orm.NewConfig()
+	config.Set("ETH_GAS_BUMP_PERCENT", 10)
+	config.Set("ETH_GAS_BUMP_WEI", 1)
+	config.Set("ETH_GAS_PRICE_DEFAULT", 150)
+	txm := &EthTxManager{config: config}
+
+	tests := []struct {
+		name string
+		fees txFees
+		want txFees
+	}{
+		{"legacy", txFees{GasPrice: big.NewInt(100)}, txFees{GasPrice: big.NewInt(110)}},
+		{"dynamic", txFees{GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(200)}, txFees{GasTipCap: big.NewInt(110), GasFeeCap: big.NewInt(220)}},
+		{"dynamic below current fee cap", txFees{GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(100)}, txFees{GasTipCap: big.NewInt(11), GasFeeCap: big.NewInt(150)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, txm.bumpFees(test.fees))
+		})
+	}
+}
+
+func TestManagedAccount_observeNonceGap(t *testing.T) {
+	ma := NewManagedAccount(accounts.Account{}, 10)
+
+	_, ok := ma.observeNonceGap(10, 1)
+	assert.False(t, ok)
+
+	gap, ok := ma.observeNonceGap(7, 2)
+	assert.True(t, ok)
+	assert.Equal(t, nonceGap{start: 7, end: 10, seenAt: 2}, gap)
+
+	ma.nonce = 11
+	gap, ok = ma.observeNonceGap(7, 5)
+	assert.True(t, ok)
+	assert.Equal(t, nonceGap{start: 7, end: 11, seenAt: 2}, gap, "gap is first seen at height 2")
+
+	gap, ok = ma.observeNonceGap(8, 6)
+	assert.True(t, ok)
+	assert.Equal(t, nonceGap{start: 8, end: 11, seenAt: 6}, gap, "progress restarts the count")
+
+	_, ok = ma.observeNonceGap(11, 7)
+	assert.False(t, ok)
+	assert.Nil(t, ma.nonceGap)
+}
+
+func TestTxManager_selectAccount(t *testing.T) {
+	t.Parallel()
+
+	newAccount := func(address string, balance int64) *ManagedAccount {
+		ma := NewManagedAccount(accounts.Account{Address: common.HexToAddress(address)}, 0)
+		ma.SetBalance(assets.NewEth(balance))
+		return ma
+	}
+	a := newAccount("0x1", 100)
+	b := newAccount("0x2", 300)
+	c := newAccount("0x3", 200)
+
+	tests := []struct {
+		name       string
+		policy     orm.KeySelectionPolicy
+		minBalance int64
+		want       []*ManagedAccount
+	}{
+		{"round robin", orm.KeySelectionRoundRobin, 0, []*ManagedAccount{a, b, c, a}},
+		{"round robin skipping low balances", orm.KeySelectionRoundRobin, 150, []*ManagedAccount{b, c, b, c}},
+		{"highest balance", orm.KeySelectionHighestBalance, 0, []*ManagedAccount{b, b}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := orm.NewConfig()
+			config.Set("ETH_KEY_SELECTION_POLICY", string(test.policy))
+			config.Set("ETH_KEY_MIN_BALANCE", test.minBalance)
+			txm := &EthTxManager{
+				config:            config,
+				accountsMutex:     &sync.Mutex{},
+				availableAccounts: []*ManagedAccount{a, b, c},
+			}
+
+			for _, want := range test.want {
+				ma, err := txm.selectAccount()
+				require.NoError(t, err)
+				assert.Equal(t, want.Address, ma.Address)
+			}
+		})
+	}
+
+	t.Run("no funded accounts", func(t *testing.T) {
+		config := orm.NewConfig()
+		config.Set("ETH_KEY_MIN_BALANCE", 1000)
+		txm := &EthTxManager{
+			config:            config,
+			accountsMutex:     &sync.Mutex{},
+			availableAccounts: []*ManagedAccount{a, b, c},
+		}
+
+		_, err := txm.selectAccount()
+		assert.Equal(t, ErrNoFundedAccounts, err)
+	})
+}
+
+func TestTxManager_preferAccount_LeastPending(t *testing.T) {
+	t.Parallel()
+
+	a := NewManagedAccount(accounts.Account{Address: common.HexToAddress("0x1")}, 0)
+	b := NewManagedAccount(accounts.Account{Address: common.HexToAddress("0x2")}, 0)
+	pending := map[common.Address]int{a.Address: 3, b.Address: 1}
+
+	assert.True(t, preferAccount(orm.KeySelectionLeastPending, b, a, pending))
+	assert.False(t, preferAccount(orm.KeySelectionLeastPending, a, b, pending))
+	assert.False(t, preferAccount(orm.KeySelectionLeastPending, a, a, pending))
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/eth"
@@ -435,6 +436,49 @@ func TestTxManager_BumpGasUntilSafe_atGasBumpThreshold(t *testing.T) {
 	ethMock.EventuallyAllCalled(t)
 }
 
+func TestTxManager_CancelTx(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+
+	store := app.Store
+	config := store.Config
+
+	txm := store.TxManager
+	from := cltest.GetAccountAddress(t, store)
+	to := cltest.NewAddress()
+	sentAt := uint64(23456)
+	ethMock := app.EthMock
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	ethMock.Register("eth_chainId", config.ChainID())
+	require.NoError(t, app.StartAndConnect())
+
+	original := cltest.CreateTxWithNonceGasPriceAndRecipient(t, store, from, to, sentAt, 0, 1)
+	require.Len(t, original.Attempts, 1)
+
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+
+	tx, err := txm.CancelTx(original.Attempts[0].Hash)
+	require.NoError(t, err)
+	ethMock.EventuallyAllCalled(t)
+
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, to, tx.To)
+	assert.Equal(t, original.Data, tx.Data)
+	assert.Equal(t, original.Value, tx.Value)
+	assert.Equal(t, original.GasLimit, tx.GasLimit)
+	require.Len(t, tx.Attempts, 2)
+	assert.False(t, tx.Attempts[0].Cancellation)
+	assert.True(t, tx.Attempts[1].Cancellation)
+	assert.True(t, tx.Cancelled())
+	assert.Equal(t, tx.Attempts[1].Hash, tx.Hash)
+
+	_, err = txm.CancelTx(cltest.NewHash())
+	assert.Error(t, err)
+}
+
 func TestTxManager_BumpGasUntilSafe_atGasBumpThreshold_bumpsGasMoreInCaseOfUnderpricedTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -854,6 +898,286 @@ func TestTxManager_CheckAttempt(t *testing.T) {
 	ethMock.EventuallyAllCalled(t)
 }
 
+func TestTxManager_CheckAttempt_finalityTag(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+
+	store := app.Store
+	config := store.Config
+	config.Set("ETH_FINALITY_TAG", "finalized")
+	config.Set("ETH_NONCE_GAP_REPAIR_THRESHOLD", 0)
+
+	ethMock := app.EthMock
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	ethMock.Register("eth_chainId", config.ChainID())
+	require.NoError(t, app.StartAndConnect())
+
+	txm := store.TxManager
+
+	from := cltest.GetAccountAddress(t, store)
+	sentAt := uint64(14770)
+	tx := cltest.CreateTx(t, store, from, sentAt)
+	require.Len(t, tx.Attempts, 1)
+	retrievedReceipt := eth.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.Int(sentAt)}
+
+	// The finalized block can't be fetched, so nothing is final
+	txm.OnNewHead(cltest.Head(sentAt + 1))
+	ethMock.Register("eth_getTransactionReceipt", retrievedReceipt)
+	ethMock.RegisterError("eth_getBlockByNumber", "connection refused")
+
+	_, state, err := txm.CheckAttempt(tx.Attempts[0], sentAt+config.MinOutgoingConfirmations())
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Confirmed, state)
+	ethMock.EventuallyAllCalled(t)
+
+	// The receipt has enough confirmations, but its block is not final
+	txm.OnNewHead(cltest.Head(sentAt + 2))
+	ethMock.Register("eth_getTransactionReceipt", retrievedReceipt)
+	ethMock.Register("eth_getBlockByNumber", &eth.BlockHeader{Number: hexutil.Big(*big.NewInt(int64(sentAt) - 1))})
+
+	_, state, err = txm.CheckAttempt(tx.Attempts[0], sentAt+config.MinOutgoingConfirmations())
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Confirmed, state)
+	ethMock.EventuallyAllCalled(t)
+
+	// The finalized block is only fetched once per head
+	ethMock.Register("eth_getTransactionReceipt", retrievedReceipt)
+
+	_, state, err = txm.CheckAttempt(tx.Attempts[0], sentAt+config.MinOutgoingConfirmations())
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Confirmed, state)
+	ethMock.EventuallyAllCalled(t)
+
+	// The finalized block has reached the receipt's
+	txm.OnNewHead(cltest.Head(sentAt + 3))
+	ethMock.Register("eth_getTransactionReceipt", retrievedReceipt)
+	ethMock.Register("eth_getBlockByNumber", &eth.BlockHeader{Number: hexutil.Big(*big.NewInt(int64(sentAt)))})
+
+	_, state, err = txm.CheckAttempt(tx.Attempts[0], sentAt)
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Safe, state)
+	ethMock.EventuallyAllCalled(t)
+
+	// Nodes that don't support the tag count confirmations instead
+	txm.OnNewHead(cltest.Head(sentAt + 4))
+	ethMock.Register("eth_getTransactionReceipt", retrievedReceipt)
+	ethMock.RegisterError("eth_getBlockByNumber", "invalid argument 0: hex string without 0x prefix")
+
+	_, state, err = txm.CheckAttempt(tx.Attempts[0], sentAt+config.MinOutgoingConfirmations())
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Safe, state)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_CheckAttempt_jobFinalityTag(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+
+	store := app.Store
+	config := store.Config
+	config.Set("ETH_NONCE_GAP_REPAIR_THRESHOLD", 0)
+	require.Equal(t, models.FinalityTagLatest, config.EthFinalityTag())
+
+	ethMock := app.EthMock
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	ethMock.Register("eth_chainId", config.ChainID())
+	require.NoError(t, store.CreateHead(cltest.Head(14770)))
+	require.NoError(t, app.StartAndConnect())
+
+	txm := store.TxManager
+
+	// The job waits for finalized blocks, unlike the node
+	job := cltest.NewJobWithWebInitiator()
+	job.FinalityTag = models.FinalityTagFinalized
+	require.NoError(t, store.CreateJob(&job))
+	run := cltest.CreateJobRunWithStatus(t, store, job, models.RunStatusPendingConfirmations)
+
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	tx, err := txm.CreateTxWithGas(null.StringFrom(run.ID.String()), cltest.NewAddress(), []byte{}, nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, models.FinalityTagFinalized, tx.FinalityTag)
+	require.Len(t, tx.Attempts, 1)
+	ethMock.EventuallyAllCalled(t)
+
+	sentAt := tx.SentAt
+	retrievedReceipt := eth.TxReceipt{Hash: tx.Attempts[0].Hash, BlockNumber: cltest.Int(sentAt)}
+
+	// The receipt has enough confirmations, but its block is not finalized
+	txm.OnNewHead(cltest.Head(sentAt + 1))
+	ethMock.Register("eth_getTransactionReceipt", retrievedReceipt)
+	ethMock.Register("eth_getBlockByNumber", &eth.BlockHeader{Number: hexutil.Big(*big.NewInt(int64(sentAt) - 1))})
+
+	_, state, err := txm.CheckAttempt(tx.Attempts[0], sentAt+config.MinOutgoingConfirmations())
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Confirmed, state)
+	ethMock.EventuallyAllCalled(t)
+
+	// Transactions of other jobs count confirmations, as the node does
+	other := cltest.CreateTx(t, store, cltest.GetAccountAddress(t, store), sentAt)
+	assert.Equal(t, models.FinalityTag(""), other.FinalityTag)
+	ethMock.Register("eth_getTransactionReceipt", retrievedReceipt)
+
+	_, state, err = txm.CheckAttempt(other.Attempts[0], sentAt+config.MinOutgoingConfirmations())
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Safe, state)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_OnReorg_confirmsTxsMinedInOrphanedBlocksAgain(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+
+	store := app.Store
+	config := store.Config
+	config.Set("ETH_NONCE_GAP_REPAIR_THRESHOLD", 0)
+
+	ethMock := app.EthMock
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	ethMock.Register("eth_chainId", config.ChainID())
+	require.NoError(t, app.StartAndConnect())
+
+	txm := store.TxManager
+
+	from := cltest.GetAccountAddress(t, store)
+	sentAt := uint64(14770)
+	tx := cltest.CreateTx(t, store, from, sentAt)
+	require.Len(t, tx.Attempts, 1)
+	tx.Attempts[0].ReceiptBlockNumber = null.IntFrom(int64(sentAt))
+	require.NoError(t, store.MarkTxSafe(tx, tx.Attempts[0]))
+
+	// The reorg orphans the block of the receipt
+	txm.(strpkg.ReorgTrackable).OnReorg(cltest.Head(sentAt-1), cltest.Head(sentAt+1))
+
+	tx, err := store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.False(t, tx.Confirmed)
+	assert.False(t, tx.Attempts[0].Confirmed)
+	assert.False(t, tx.Attempts[0].ReceiptBlockNumber.Valid)
+
+	// The tx is not mined on the new chain yet
+	ethMock.Register("eth_getTransactionReceipt", eth.TxReceipt{})
+	txm.OnNewHead(cltest.Head(sentAt + 1))
+	ethMock.EventuallyAllCalled(t)
+
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.False(t, tx.Confirmed)
+
+	// It lands in a later block, and is checked on each head until it is safe
+	minedAt := sentAt + 3
+	receipt := eth.TxReceipt{Hash: tx.Attempts[0].Hash, BlockNumber: cltest.Int(minedAt)}
+	ethMock.Register("eth_getTransactionReceipt", receipt)
+	ethMock.Register("eth_getBalance", "0x0100")
+	ethMock.Register("eth_call", "0x0100")
+	txm.OnNewHead(cltest.Head(minedAt + config.MinOutgoingConfirmations()))
+	ethMock.EventuallyAllCalled(t)
+
+	require.Eventually(t, func() bool {
+		tx, err = store.FindTx(tx.ID)
+		require.NoError(t, err)
+		return tx.Confirmed
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, null.IntFrom(int64(minedAt)), tx.Attempts[0].ReceiptBlockNumber)
+
+	// Once safe, it is no longer checked
+	txm.OnNewHead(cltest.Head(minedAt + config.MinOutgoingConfirmations() + 1))
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_OnReorg_reopensRunsOfTxsMinedInOrphanedBlocks(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+
+	store := app.Store
+	config := store.Config
+	config.Set("ETH_NONCE_GAP_REPAIR_THRESHOLD", 0)
+
+	ethMock := app.EthMock
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	ethMock.Register("eth_chainId", config.ChainID())
+	require.NoError(t, app.StartAndConnect())
+
+	txm := store.TxManager
+
+	from := cltest.GetAccountAddress(t, store)
+	sentAt := uint64(14770)
+	tx := cltest.CreateTx(t, store, from, sentAt)
+	tx.Attempts[0].ReceiptBlockNumber = null.IntFrom(int64(sentAt))
+	require.NoError(t, store.MarkTxSafe(tx, tx.Attempts[0]))
+
+	// The run completed on the receipt of the orphaned block
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	run := cltest.NewJobRun(job)
+	run.TaskRuns[0].Status = models.RunStatusCompleted
+	run.TaskRuns[0].Result.Data = cltest.JSONFromString(t,
+		`{"result": "%s", "ethereumReceipts": [{"transactionHash": "%s", "blockNumber": "0x39b2"}]}`,
+		tx.Attempts[0].Hash.Hex(), tx.Attempts[0].Hash.Hex())
+	run.SetStatus(models.RunStatusCompleted)
+	require.NoError(t, store.CreateJobRun(&run))
+	tx.SurrogateID = null.StringFrom(run.ID.String())
+	require.NoError(t, store.SaveTx(tx))
+
+	txm.(strpkg.ReorgTrackable).OnReorg(cltest.Head(sentAt-1), cltest.Head(sentAt+1))
+
+	// The run confirms the tx again on each head, rather than the tx manager
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, run.GetStatus())
+	assert.False(t, run.FinishedAt.Valid)
+	assert.Equal(t, models.RunStatusPendingConfirmations, run.TaskRuns[0].Status)
+	assert.Equal(t, tx.Attempts[0].Hash.Hex(), run.TaskRuns[0].Result.Data.Get("result").String())
+	assert.Empty(t, run.TaskRuns[0].Result.Data.Get("ethereumReceipts").Array())
+
+	txm.OnNewHead(cltest.Head(sentAt + 1))
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_CheckAttempt_recordsRevertReason(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+
+	store := app.Store
+	config := store.Config
+
+	ethMock := app.EthMock
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	ethMock.Register("eth_chainId", config.ChainID())
+	require.NoError(t, app.StartAndConnect())
+
+	txm := store.TxManager
+
+	from := cltest.GetAccountAddress(t, store)
+	sentAt := uint64(14770)
+	tx := cltest.CreateTx(t, store, from, sentAt)
+	require.Len(t, tx.Attempts, 1)
+
+	status := hexutil.Uint64(0)
+	retrievedReceipt := eth.TxReceipt{Hash: tx.Attempts[0].Hash, BlockNumber: cltest.Int(sentAt), Status: &status}
+	ethMock.Register("eth_getTransactionReceipt", retrievedReceipt)
+	ethMock.Register("eth_call", "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f6e6f7420656e6f756768204c494e4b0000000000000000000000000000000000")
+
+	receipt, state, err := txm.CheckAttempt(tx.Attempts[0], sentAt+config.MinOutgoingConfirmations())
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Safe, state)
+	assert.True(t, receipt.Failed())
+	ethMock.EventuallyAllCalled(t)
+
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom("not enough LINK"), tx.RevertReason)
+}
+
 func TestTxManager_CheckAttempt_error(t *testing.T) {
 	t.Parallel()
 
@@ -1194,6 +1518,68 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 	}
 }
 
+func TestTxManager_CreateTxWithGas_EstimatesGasLimit(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	store := app.Store
+	config := store.Config
+	config.Set("ETH_GAS_LIMIT_ESTIMATION_ENABLED", true)
+	config.Set("ETH_GAS_LIMIT_MULTIPLIER", 1.5)
+	config.Set("ETH_GAS_LIMIT_MAX", 200000)
+	manager := store.TxManager
+
+	to := cltest.NewAddress()
+	data, err := hex.DecodeString("0000abcdef")
+	assert.NoError(t, err)
+	ethMock := app.EthMock
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(256))
+		ethMock.Register("eth_chainId", config.ChainID())
+	})
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(1)))
+	assert.NoError(t, app.StartAndConnect())
+
+	t.Run("multiplies estimate", func(t *testing.T) {
+		ethMock.Register("eth_estimateGas", "0x186a0") // 100000
+		ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+
+		tx, err := manager.CreateTxWithGas(null.String{}, to, data, nil, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(150000), tx.GasLimit)
+		ethMock.EventuallyAllCalled(t)
+	})
+
+	t.Run("caps at maximum", func(t *testing.T) {
+		ethMock.Register("eth_estimateGas", "0x249f0") // 150000
+		ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+
+		tx, err := manager.CreateTxWithGas(null.String{}, to, data, nil, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(200000), tx.GasLimit)
+		ethMock.EventuallyAllCalled(t)
+	})
+
+	t.Run("errors when the estimate exceeds the maximum", func(t *testing.T) {
+		ethMock.Register("eth_estimateGas", "0x30d41") // 200001
+
+		_, err := manager.CreateTxWithGas(null.String{}, to, data, nil, 0)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, strpkg.ErrGasLimitExceedsMax))
+		ethMock.EventuallyAllCalled(t)
+	})
+
+	t.Run("does not send reverting transaction", func(t *testing.T) {
+		ethMock.RegisterError("eth_estimateGas", "execution reverted: not enough LINK")
+
+		_, err := manager.CreateTxWithGas(null.String{}, to, data, nil, 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "execution reverted: not enough LINK")
+		ethMock.EventuallyAllCalled(t)
+	})
+}
+
 func TestTxManager_RebroadcastUnconfirmedTxsOnReconnect(t *testing.T) {
 	t.Parallel()
 
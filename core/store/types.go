@@ -11,3 +11,11 @@ type HeadTrackable interface {
 	Disconnect()
 	OnNewHead(*models.Head)
 }
+
+// ReorgTrackable is a HeadTrackable that reverts the work it did on blocks
+// orphaned by a chain reorganization. OnReorg is called with the last block
+// both chains share, before OnNewHead is called with the new head.
+type ReorgTrackable interface {
+	HeadTrackable
+	OnReorg(ancestor *models.Head, head *models.Head)
+}
@@ -0,0 +1,59 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// HeadsController displays the heads the node received.
+type HeadsController struct {
+	App chainlink.Application
+}
+
+// Index returns the paginated heads of the chain, newest first, with the
+// depth of each below the height of the chain. While the Ethereum node is
+// syncing, that is the highest block it knows of rather than its own height,
+// so the depth of the newest head shows how far the node lags behind.
+// Example:
+//  "<application>/heads?chainId=42"
+func (hc *HeadsController) Index(c *gin.Context, size, page, offset int) {
+	var chainID *utils.Big
+	if param := c.Query("chainId"); param != "" {
+		id, ok := new(big.Int).SetString(param, 10)
+		if !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid chainId %s", param))
+			return
+		}
+		chainID = utils.NewBig(id)
+	}
+
+	chain, err := hc.App.GetStore().ForChain(chainID)
+	if errors.Cause(err) == store.ErrUnknownChain {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	heads, count, err := chain.Heads(chain.ChainID, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var chainHeight *big.Int
+	if height, err := chain.TxManager.GetChainHeight(); err == nil {
+		chainHeight = new(big.Int).SetUint64(height)
+	}
+	pheads := make([]presenters.Head, len(heads))
+	for i, head := range heads {
+		pheads[i] = presenters.NewHead(head, chainHeight)
+	}
+	paginatedResponse(c, "Heads", size, page, pheads, count, err)
+}
@@ -0,0 +1,93 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+	"github.com/smartcontractkit/chainlink/core/web"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadsController_Index_Success(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_SYNC_CHECK_INTERVAL", "0")
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	store := app.GetStore()
+	for idx := 1; idx <= 3; idx++ {
+		require.NoError(t, store.CreateHead(cltest.Head(idx)))
+	}
+	app.EthMock.Register("eth_syncing", false)
+	app.EthMock.Register("eth_blockNumber", "0x5")
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/heads?size=2")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var heads []presenters.Head
+	body := cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParsePaginatedResponse(body, &heads, &links))
+	assert.NotEmpty(t, links["next"].Href)
+
+	require.Len(t, heads, 2)
+	assert.Equal(t, int64(3), heads[0].Number)
+	assert.Equal(t, int64(2), heads[0].Depth.Int64)
+	assert.Equal(t, int64(2), heads[1].Number)
+}
+
+func TestHeadsController_Index_Syncing(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_SYNC_CHECK_INTERVAL", "0")
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	require.NoError(t, app.GetStore().CreateHead(cltest.Head(3)))
+	app.EthMock.Register("eth_syncing", map[string]string{
+		"startingBlock": "0x0",
+		"currentBlock":  "0x3",
+		"highestBlock":  "0x9",
+	})
+	app.EthMock.Register("eth_blockNumber", "0x3")
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/heads")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var heads []presenters.Head
+	body := cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParsePaginatedResponse(body, &heads, &links))
+
+	require.Len(t, heads, 1)
+	assert.Equal(t, int64(6), heads[0].Depth.Int64)
+}
+
+func TestHeadsController_Index_UnknownChain(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/heads?chainId=12345")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -0,0 +1,43 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthController reports whether the node's Ethereum nodes are in sync, for
+// load balancers and monitoring.
+type HealthController struct {
+	App chainlink.Application
+}
+
+type chainHealth struct {
+	ChainID    string  `json:"chainId"`
+	InSync     bool    `json:"inSync"`
+	Syncing    bool    `json:"syncing"`
+	LagSeconds float64 `json:"lagSeconds"`
+}
+
+// Show returns the sync status of each chain, with a 503 if any is out of
+// sync.
+// Example:
+//  "<application>/health"
+func (hc *HealthController) Show(c *gin.Context) {
+	status := http.StatusOK
+	var chains []chainHealth
+	for _, s := range hc.App.SyncStatuses() {
+		if !s.InSync {
+			status = http.StatusServiceUnavailable
+		}
+		chains = append(chains, chainHealth{
+			ChainID:    s.ChainID.String(),
+			InSync:     s.InSync,
+			Syncing:    s.Syncing,
+			LagSeconds: s.Lag.Seconds(),
+		})
+	}
+	c.JSON(status, gin.H{"healthy": status == http.StatusOK, "chains": chains})
+}
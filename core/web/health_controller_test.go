@@ -0,0 +1,56 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type healthResponse struct {
+	Healthy bool `json:"healthy"`
+	Chains  []struct {
+		ChainID string `json:"chainId"`
+		InSync  bool   `json:"inSync"`
+	} `json:"chains"`
+}
+
+func TestHealthController_Show_InSync(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.StartAndConnect())
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/health")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var health healthResponse
+	require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(t, resp), &health))
+	assert.True(t, health.Healthy)
+	require.Len(t, health.Chains, 1)
+	assert.Equal(t, app.Store.Config.ChainID().String(), health.Chains[0].ChainID)
+	assert.True(t, health.Chains[0].InSync)
+}
+
+func TestHealthController_Show_NotConnected(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/health")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusServiceUnavailable)
+
+	var health healthResponse
+	require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(t, resp), &health))
+	assert.False(t, health.Healthy)
+}
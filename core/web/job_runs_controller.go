@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // JobRunsController manages JobRun requests in the node.
@@ -83,11 +85,20 @@ func (jrc *JobRunsController) Create(c *gin.Context) {
 		return
 	}
 
-	jr, err := jrc.App.Create(j.ID, initiator, nil, &models.RunRequest{RequestParams: data})
+	runRequest := &models.RunRequest{RequestParams: data}
+	if key := c.GetHeader("Idempotency-Key"); key != "" {
+		runRequest.IdempotencyKey = null.StringFrom(key)
+	}
+
+	jr, err := jrc.App.Create(j.ID, initiator, nil, runRequest)
 	if errors.Cause(err) == orm.ErrorNotFound {
 		jsonAPIError(c, http.StatusNotFound, errors.New("Job not found"))
 		return
 	}
+	if _, ok := errors.Cause(err).(services.RunRejectedError); ok {
+		jsonAPIError(c, http.StatusTooManyRequests, err)
+		return
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
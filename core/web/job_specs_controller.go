@@ -2,7 +2,9 @@ package web
 
 import (
 	"net/http"
+	"time"
 
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -61,6 +63,13 @@ func (jsc *JobSpecsController) getAndCheckJobSpec(
 		// https://www.pivotaltracker.com/story/show/171164115
 		return models.JobSpec{}, http.StatusBadRequest, err
 	}
+	return jsc.checkJobSpec(jsr)
+}
+
+// checkJobSpec builds a job spec from jsr and validates it, with the same
+// return semantics as getAndCheckJobSpec.
+func (jsc *JobSpecsController) checkJobSpec(
+	jsr models.JobSpecRequest) (js models.JobSpec, httpStatus int, err error) {
 	js = models.NewJobFromRequest(jsr)
 	if err := jsc.requireImplemented(js); err != nil {
 		return models.JobSpec{}, http.StatusNotImplemented, err
@@ -92,6 +101,32 @@ func (jsc *JobSpecsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.JobSpec{JobSpec: js}, "job")
 }
 
+// Simulate validates a JobSpec and runs its tasks once against the supplied
+// request data, without saving the job or any of its runs. Ethereum
+// transactions are encoded but never sent.
+// Example:
+//  "<application>/specs/simulate"
+func (jsc *JobSpecsController) Simulate(c *gin.Context) {
+	if c.Param("SpecID") != "simulate" {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Not found"))
+		return
+	}
+
+	var request models.JobSimulationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	js, httpStatus, err := jsc.checkJobSpec(request.JobSpec)
+	if err != nil {
+		jsonAPIError(c, httpStatus, err)
+		return
+	}
+
+	simulation := services.SimulateJob(js, request.Data, jsc.App.GetStore())
+	jsonAPIResponse(c, simulation, "simulation")
+}
+
 // Show returns the details of a JobSpec.
 // Example:
 //  "<application>/specs/:SpecID"
@@ -112,7 +147,9 @@ func (jsc *JobSpecsController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, jobPresenter(jsc, j), "job")
+	job := jobPresenter(jsc, j)
+	job.Costs = jobCosts(jsc, j)
+	jsonAPIResponse(c, job, "job")
 }
 
 // Destroy soft deletes a job spec.
@@ -143,3 +180,34 @@ func jobPresenter(jsc *JobSpecsController, job models.JobSpec) presenters.JobSpe
 	jobLinkEarned, _ := store.LinkEarnedFor(&job)
 	return presenters.JobSpec{JobSpec: job, Earnings: jobLinkEarned}
 }
+
+// jobCostWindows are the periods over which a job's earnings and spending
+// are totalled when showing it. A zero duration means all time.
+var jobCostWindows = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"24h", 24 * time.Hour},
+	{"7d", 7 * 24 * time.Hour},
+	{"30d", 30 * 24 * time.Hour},
+	{"all", 0},
+}
+
+func jobCosts(jsc *JobSpecsController, job models.JobSpec) []presenters.JobCosts {
+	store := jsc.App.GetStore()
+	now := time.Now()
+	costs := make([]presenters.JobCosts, 0, len(jobCostWindows))
+	for _, window := range jobCostWindows {
+		since := time.Time{}
+		if window.duration > 0 {
+			since = now.Add(-window.duration)
+		}
+		runCosts, err := store.RunCostsFor(&job, since)
+		if err != nil {
+			logger.Errorw("Unable to total run costs", "job", job.ID.String(), "error", err)
+			return nil
+		}
+		costs = append(costs, presenters.NewJobCosts(window.name, runCosts, store.Config.LinkPerEth()))
+	}
+	return costs
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
@@ -453,6 +454,74 @@ func TestJobSpecsController_Create_Task_Only(t *testing.T) {
 	assert.Equal(t, expected, strings.TrimSpace(body))
 }
 
+func TestJobSpecsController_Simulate(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	body := `{
+		"jobSpec": {
+			"initiators": [{"type": "web"}],
+			"tasks": [
+				{"type": "ethuint256"},
+				{"type": "ethtx", "params": {"address": "0x356a04bCe728ba4c62A30294A55E6A8600a320B3", "functionSelector": "0x609ff1bd"}}
+			]
+		},
+		"data": {"result": "42"}
+	}`
+	resp, cleanup := client.Post("/v2/specs/simulate", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var simulation models.JobSimulation
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &simulation))
+	assert.Equal(t, models.RunStatusCompleted, simulation.Status)
+	require.Len(t, simulation.TaskRuns, 2)
+	assert.Equal(t,
+		"0x609ff1bd000000000000000000000000000000000000000000000000000000000000002a",
+		simulation.TaskRuns[1].Output.Get("result").String())
+
+	_, count, err := app.Store.JobsSorted(orm.Ascending, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestJobSpecsController_Simulate_InvalidJob(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	body := `{"jobSpec": {"initiators": [{"type": "web"}]}}`
+	resp, cleanup := client.Post("/v2/specs/simulate", bytes.NewBufferString(body))
+	defer cleanup()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "Response should be caller error")
+
+	expected := `{"errors":[{"detail":"Must have at least one Initiator and one Task"}]}`
+	assert.Equal(t, expected, strings.TrimSpace(string(cltest.ParseResponseBody(t, resp))))
+}
+
+func TestJobSpecsController_Simulate_OtherSpecPath(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	body := `{"jobSpec": {"initiators": [{"type": "web"}], "tasks": [{"type": "noop"}]}}`
+	resp, cleanup := client.Post("/v2/specs/"+models.NewID().String(), bytes.NewBufferString(body))
+	defer cleanup()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
 func BenchmarkJobSpecsController_Show(b *testing.B) {
 	app, cleanup := cltest.NewApplication(b)
 	defer cleanup()
@@ -216,6 +216,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.DELETE("/external_initiators/:Name", eia.Destroy)
 
 		authv2.POST("/specs", j.Create)
+		// gin can't register the static /specs/simulate next to the
+		// /specs/:SpecID/runs wildcard, so Simulate matches the segment itself.
+		authv2.POST("/specs/:SpecID", j.Simulate)
 		authv2.GET("/specs", paginatedRequest(j.Index))
 		authv2.GET("/specs/:SpecID", j.Show)
 		authv2.DELETE("/specs/:SpecID", j.Destroy)
@@ -254,11 +257,18 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.POST("/transactions/:TxHash/cancel", txs.Cancel)
+
+		hc := HeadsController{app}
+		authv2.GET("/heads", paginatedRequest(hc.Index))
 
 		bdc := BulkDeletesController{app}
 		authv2.DELETE("/bulk_delete_runs", bdc.Delete)
 	}
 
+	hc := HealthController{app}
+	r.GET("/health", hc.Show)
+
 	ping := PingController{app}
 	userOrEI := r.Group("/v2", RequireAuth(app.GetStore(),
 		AuthenticateExternalInitiator,
@@ -3,7 +3,10 @@ package web
 import (
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 
@@ -46,3 +49,48 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewTxFromAttempt(*txAttempt), "transaction")
 }
+
+// Cancel replaces an unconfirmed Ethereum Transaction with a zero value
+// transaction to its sender at a bumped gas price, on the chain it was sent
+// on, and cancels the job run that sent it.
+// Example:
+//  "<application>/transactions/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	txAttempt, err := tc.App.GetStore().FindTxAttempt(hash)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	chain, err := tc.App.GetStore().ForChain(txAttempt.Tx.ChainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	tx, err := chain.TxManager.CancelTx(hash)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	} else if err == store.ErrTxAlreadyConfirmed {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if tx.SurrogateID.Valid {
+		if runID, err := models.NewIDFromString(tx.SurrogateID.String); err == nil {
+			if _, err := tc.App.Cancel(runID); err != nil {
+				logger.Warnw("Unable to cancel job run of cancelled tx", "txHash", hash.Hex(), "runID", runID.String(), "error", err)
+			}
+		}
+	}
+
+	jsonAPIResponse(c, presenters.NewTx(tx), "transaction")
+}
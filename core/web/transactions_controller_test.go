@@ -5,12 +5,14 @@ import (
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
 
 	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -153,3 +155,104 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	defer cleanup()
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+
+	ethMock := app.EthMock
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_chainId", app.Store.Config.ChainID())
+		ethMock.Register("eth_getTransactionCount", "0x100")
+	})
+
+	require.NoError(t, app.StartAndConnect())
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+
+	tx := cltest.CreateTx(t, store, from, 1)
+
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	resp, cleanup := client.Post("/v2/transactions/"+tx.Hash.String()+"/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	ethMock.EventuallyAllCalled(t)
+
+	ptx := presenters.Tx{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.NotEqual(t, tx.Hash, ptx.Hash)
+	assert.Equal(t, tx.To, *ptx.To)
+
+	cancelled, err := store.FindTx(tx.ID)
+	require.NoError(t, err)
+	require.Len(t, cancelled.Attempts, 2)
+	assert.Equal(t, tx.To, cancelled.To)
+	assert.Equal(t, tx.Data, cancelled.Data)
+	assert.True(t, cancelled.Attempts[1].Cancellation)
+	assert.Equal(t, cancelled.Attempts[1].Hash, ptx.Hash)
+
+	t.Run("not found", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/transactions/"+cltest.NewHash().String()+"/cancel", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("already confirmed", func(t *testing.T) {
+		confirmed := cltest.CreateTx(t, store, from, 2)
+		require.NoError(t, store.MarkTxSafe(confirmed, confirmed.Attempts[0]))
+
+		resp, cleanup := client.Post("/v2/transactions/"+confirmed.Hash.String()+"/cancel", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+	})
+}
+
+func TestTransactionsController_Cancel_OnAnotherChain(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_CHAINS", `[{"ETH_CHAIN_ID": "100", "ETH_URL": "ws://xdai:8546"}]`)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+
+	ethMock := app.EthMock
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_chainId", app.Store.Config.ChainID())
+		ethMock.Register("eth_getTransactionCount", "0x100")
+	})
+	store := app.GetStore()
+	require.Len(t, store.Chains(), 1)
+	xdai := store.Chains()[0]
+	xdaiMock := cltest.MockEthOnStore(t, xdai, cltest.LenientEthMock)
+
+	require.NoError(t, app.StartAndConnect())
+	xdaiMock.Register("eth_getTransactionCount", "0x0")
+	require.NoError(t, xdai.TxManager.Connect(cltest.Head(1)))
+
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+	tx := cltest.CreateTx(t, store, from, 1)
+	tx.ChainID = xdai.ChainID
+	require.NoError(t, store.SaveTx(tx))
+
+	// Only the node of the chain the tx was sent on is sent the replacement,
+	// as the strict mock of the primary chain fails on unexpected calls.
+	xdaiMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	resp, cleanup := client.Post("/v2/transactions/"+tx.Hash.String()+"/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	xdaiMock.EventuallyAllCalled(t)
+
+	cancelled, err := store.FindTx(tx.ID)
+	require.NoError(t, err)
+	require.Len(t, cancelled.Attempts, 2)
+	assert.True(t, cancelled.Attempts[1].Cancellation)
+	assert.Equal(t, xdai.ChainID, cancelled.ChainID)
+
+	_, err = store.TxManager.CancelTx(tx.Hash)
+	assert.Equal(t, strpkg.ErrTxOnOtherChain, errors.Cause(err))
+}
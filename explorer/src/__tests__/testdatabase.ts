@@ -12,6 +12,11 @@ const TRUNCATE_TABLES: string[] = [
   'chainlink_node',
   'ethereum_head',
   'ethereum_log',
+  'flux_aggregator_answer_updated',
+  'flux_aggregator_new_round',
+  'flux_aggregator_submission_received',
+  'ingester_cursor',
+  'oracle_request',
 ]
 
 export const clearDb = async () => {
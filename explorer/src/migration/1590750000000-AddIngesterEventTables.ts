@@ -0,0 +1,110 @@
+import { MigrationInterface, QueryRunner } from 'typeorm'
+
+export class AddIngesterEventTables1590750000000 implements MigrationInterface {
+  public async up(queryRunner: QueryRunner): Promise<any> {
+    await queryRunner.query(`
+ALTER TABLE ethereum_head ADD COLUMN "orphaned" bool NOT NULL DEFAULT FALSE;
+DELETE FROM ethereum_head a USING ethereum_head b
+  WHERE a.id > b.id AND a."blockHash" = b."blockHash";
+CREATE UNIQUE INDEX "idx_ethereum_head_block_hash" ON ethereum_head ("blockHash");
+CREATE INDEX "idx_ethereum_head_number" ON ethereum_head ("number");
+
+DELETE FROM ethereum_log a USING ethereum_log b
+  WHERE a.id > b.id AND a."blockHash" = b."blockHash" AND a."index" = b."index";
+CREATE UNIQUE INDEX "idx_ethereum_log_block_hash_index" ON ethereum_log ("blockHash", "index");
+    `)
+
+    await queryRunner.query(`
+CREATE TABLE flux_aggregator_answer_updated (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "address" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "logIndex" bigint NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE,
+  "current" numeric NOT NULL,
+  "roundId" numeric NOT NULL,
+  "timestamp" numeric NOT NULL,
+  UNIQUE ("blockHash", "logIndex")
+);
+CREATE INDEX "idx_flux_aggregator_answer_updated_address_round_id" ON flux_aggregator_answer_updated ("address", "roundId");
+
+CREATE TABLE flux_aggregator_new_round (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "address" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "logIndex" bigint NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE,
+  "roundId" numeric NOT NULL,
+  "startedBy" bytea NOT NULL,
+  "startedAt" numeric NOT NULL,
+  UNIQUE ("blockHash", "logIndex")
+);
+CREATE INDEX "idx_flux_aggregator_new_round_address_round_id" ON flux_aggregator_new_round ("address", "roundId");
+
+CREATE TABLE flux_aggregator_submission_received (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "address" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "logIndex" bigint NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE,
+  "submission" numeric NOT NULL,
+  "round" bigint NOT NULL,
+  "oracle" bytea NOT NULL,
+  UNIQUE ("blockHash", "logIndex")
+);
+CREATE INDEX "idx_flux_aggregator_submission_received_address_round" ON flux_aggregator_submission_received ("address", "round");
+CREATE INDEX "idx_flux_aggregator_submission_received_oracle" ON flux_aggregator_submission_received ("oracle");
+
+CREATE TABLE oracle_request (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "address" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "logIndex" bigint NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE,
+  "specId" bytea NOT NULL,
+  "requester" bytea NOT NULL,
+  "requestId" bytea NOT NULL,
+  "payment" numeric NOT NULL,
+  "callbackAddr" bytea NOT NULL,
+  "callbackFunctionId" bytea NOT NULL,
+  "cancelExpiration" numeric NOT NULL,
+  "dataVersion" numeric NOT NULL,
+  "data" bytea NOT NULL,
+  UNIQUE ("blockHash", "logIndex")
+);
+CREATE INDEX "idx_oracle_request_address" ON oracle_request ("address");
+
+CREATE TABLE ingester_cursor (
+  "chainId" bigint PRIMARY KEY,
+  "blockNumber" bigint NOT NULL,
+  "updatedAt" timestamp without time zone DEFAULT now() NOT NULL
+);
+    `)
+  }
+
+  public async down(queryRunner: QueryRunner): Promise<any> {
+    await queryRunner.query(`
+DROP TABLE "ingester_cursor";
+DROP TABLE "oracle_request";
+DROP TABLE "flux_aggregator_submission_received";
+DROP TABLE "flux_aggregator_new_round";
+DROP TABLE "flux_aggregator_answer_updated";
+DROP INDEX "idx_ethereum_log_block_hash_index";
+DROP INDEX "idx_ethereum_head_number";
+DROP INDEX "idx_ethereum_head_block_hash";
+ALTER TABLE ethereum_head DROP COLUMN "orphaned";
+    `)
+  }
+}
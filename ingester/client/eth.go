@@ -19,6 +19,9 @@ type ETH interface {
 	SubscribeToLogs(chan<- types.Log, ethereum.FilterQuery) (Subscription, error)
 	TransactionByHash(txHash common.Hash) (*types.Transaction, error)
 	SubscribeToNewHeads(chan<- types.Header) (Subscription, error)
+	BlockNumber() (uint64, error)
+	FilterLogs(ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByHash(common.Hash) (*types.Header, error)
 }
 
 type eth struct {
@@ -47,6 +50,30 @@ func (c *eth) TransactionByHash(txHash common.Hash) (*types.Transaction, error)
 	return &tx, c.rpc.Call(&tx, "eth_getTransactionByHash", txHash.String())
 }
 
+// BlockNumber calls `eth_blockNumber` and returns the number of the latest block
+func (c *eth) BlockNumber() (uint64, error) {
+	var number hexutil.Uint64
+	err := c.rpc.Call(&number, "eth_blockNumber")
+	return uint64(number), err
+}
+
+// FilterLogs calls `eth_getLogs` for the given filter query
+func (c *eth) FilterLogs(q ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	err := c.rpc.Call(&logs, "eth_getLogs", toFilterArg(q))
+	return logs, err
+}
+
+// HeaderByHash calls `eth_getBlockByHash` and returns the header of the block
+func (c *eth) HeaderByHash(hash common.Hash) (*types.Header, error) {
+	var head *types.Header
+	err := c.rpc.Call(&head, "eth_getBlockByHash", hash, false)
+	if err == nil && head == nil {
+		err = ethereum.NotFound
+	}
+	return head, err
+}
+
 // SubscribeToNewHeads returns an instantiated subscription type, subscribing to heads
 func (c *eth) SubscribeToNewHeads(channel chan<- types.Header) (Subscription, error) {
 	ctx := context.Background()
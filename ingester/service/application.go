@@ -7,9 +7,6 @@ import (
 	"ingester/client"
 	"ingester/logger"
 
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	_ "github.com/jinzhu/gorm/dialects/postgres" // http://doc.gorm.io/database.html#connecting-to-a-database
 )
 
@@ -19,6 +16,9 @@ type Application struct {
 	Config *Config
 
 	ETHClient client.ETH
+
+	ingester *ingester
+	server   *server
 }
 
 // InterruptHandler is a function that is called after application startup
@@ -62,89 +62,33 @@ func NewApplication(config *Config) (*Application, error) {
 		return nil, err
 	}
 
-	q := ethereum.FilterQuery{}
-	logChan := make(chan types.Log)
-	_, err = ec.SubscribeToLogs(logChan, q)
-	if err != nil {
-		return nil, err
+	st := &store{db: db}
+	ing := &ingester{
+		config: config,
+		eth:    ec,
+		store:  st,
 	}
-
-	go func() {
-		logger.Debug("Listening for logs")
-		for log := range logChan {
-			address := make([]byte, 20)
-			copy(address, log.Address[:])
-
-			topics := make([]byte, len(log.Topics)*len(common.Hash{}))
-			for index, topic := range log.Topics {
-				copy(topics[index*len(common.Hash{}):], topic.Bytes())
-			}
-
-			logger.Debugw("Oberved new log", "blockHash", log.BlockHash, "index", log.Index, "removed", log.Removed)
-			_, err := db.Exec(`INSERT INTO "ethereum_log" ("address", "topics", "data", "blockNumber", "txHash", "txIndex", "blockHash", "index", "removed") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`,
-				address,
-				topics,
-				log.Data,
-				log.BlockNumber,
-				log.TxHash.Bytes(),
-				log.TxIndex,
-				log.BlockHash.Bytes(),
-				log.Index,
-				log.Removed)
-			if err != nil {
-				logger.Errorw("Insert failed", "error", err)
-			}
-		}
-	}()
-
-	headChan := make(chan types.Header)
-	_, err = ec.SubscribeToNewHeads(headChan)
-	if err != nil {
+	if err := ing.Start(); err != nil {
 		return nil, err
 	}
 
-	go func() {
-		logger.Debug("Listening for heads")
-		for head := range headChan {
-			nonce := make([]byte, 8)
-			copy(nonce, head.Nonce[:])
-
-			logger.Debugw("Observed new head", "blockHeight", head.Number, "blockHash", head.Hash())
-			_, err := db.Exec(`INSERT INTO "ethereum_head" ("blockHash", "parentHash", "uncleHash", "coinbase", "root", "txHash", "receiptHash", "bloom", "difficulty", "number", "gasLimit", "gasUsed", "time", "extra", "mixDigest", "nonce") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);`,
-				head.Hash().Bytes(),
-				head.ParentHash,
-				head.UncleHash,
-				head.Coinbase,
-				head.Root,
-				head.TxHash,
-				head.ReceiptHash,
-				head.Bloom.Bytes(),
-				head.Difficulty.String(),
-				head.Number.String(),
-				head.GasLimit,
-				head.GasUsed,
-				head.Time,
-				head.Extra,
-				head.MixDigest,
-				nonce)
-			if err != nil {
-				logger.Errorw("Insert failed", "error", err)
-			}
-		}
-	}()
-
 	return &Application{
 		ETHClient: ec,
 		Config:    config,
+		ingester:  ing,
+		server:    newServer(config, st),
 	}, nil
 }
 
 // Start will start all the services within the application and call the interrupt handler
 func (a *Application) Start(ih InterruptHandler) {
+	a.server.Start()
 	ih()
 }
 
 // Stop will call each services that requires a clean shutdown to stop
 func (a *Application) Stop() {
 	logger.Info("Shutting down")
+	a.server.Stop()
+	a.ingester.Stop()
 }
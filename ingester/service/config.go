@@ -25,6 +25,14 @@ type Config struct {
 	DatabaseUsername string `mapstructure:"db-username"`
 	// DatabasePassword of the postgres server where the ingester saves results
 	DatabasePassword string `mapstructure:"db-password"`
+	// ContractAddresses restricts ingestion to the logs of these contracts, or
+	// to the logs of any contract if empty
+	ContractAddresses []string `mapstructure:"contract-addresses"`
+	// BackfillBatchSize is the maximum number of blocks fetched by each
+	// eth_getLogs call when backfilling from the stored cursor
+	BackfillBatchSize uint64 `mapstructure:"backfill-batch-size"`
+	// HTTPPort is the port of the API serving the history of the aggregators
+	HTTPPort int `mapstructure:"http-port"`
 }
 
 // NewConfig will return an instantiated config based on the passed in defaults
@@ -45,14 +53,17 @@ func NewConfig(defaults map[string]interface{}) *Config {
 // DefaultConfig returns an instantiated config with the application defaults
 func DefaultConfig() *Config {
 	return NewConfig(map[string]interface{}{
-		"response-timeout": time.Minute * 5,
-		"eth-chain-id":     1,
-		"eth-url":          "ws://localhost:8545",
-		"db-host":          "localhost",
-		"db-name":          "explorer",
-		"db-port":          "5432",
-		"db-username":      "postgres",
-		"db-password":      "postgres",
+		"response-timeout":    time.Minute * 5,
+		"eth-chain-id":        1,
+		"eth-url":             "ws://localhost:8545",
+		"db-host":             "localhost",
+		"db-name":             "explorer",
+		"db-port":             "5432",
+		"db-username":         "postgres",
+		"db-password":         "postgres",
+		"contract-addresses":  "",
+		"backfill-batch-size": 1000,
+		"http-port":           8090,
 	})
 }
 
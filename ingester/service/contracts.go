@@ -0,0 +1,179 @@
+package service
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// contractsABI holds the events of the FluxAggregator and Oracle contracts
+// that the ingester decodes into their own tables
+const contractsABI = `[
+  {"type": "event", "name": "AnswerUpdated", "anonymous": false, "inputs": [
+    {"name": "current", "type": "int256", "indexed": true},
+    {"name": "roundId", "type": "uint256", "indexed": true},
+    {"name": "timestamp", "type": "uint256", "indexed": false}
+  ]},
+  {"type": "event", "name": "NewRound", "anonymous": false, "inputs": [
+    {"name": "roundId", "type": "uint256", "indexed": true},
+    {"name": "startedBy", "type": "address", "indexed": true},
+    {"name": "startedAt", "type": "uint256", "indexed": false}
+  ]},
+  {"type": "event", "name": "SubmissionReceived", "anonymous": false, "inputs": [
+    {"name": "submission", "type": "int256", "indexed": true},
+    {"name": "round", "type": "uint32", "indexed": true},
+    {"name": "oracle", "type": "address", "indexed": true}
+  ]},
+  {"type": "event", "name": "OracleRequest", "anonymous": false, "inputs": [
+    {"name": "specId", "type": "bytes32", "indexed": true},
+    {"name": "requester", "type": "address", "indexed": false},
+    {"name": "requestId", "type": "bytes32", "indexed": false},
+    {"name": "payment", "type": "uint256", "indexed": false},
+    {"name": "callbackAddr", "type": "address", "indexed": false},
+    {"name": "callbackFunctionId", "type": "bytes4", "indexed": false},
+    {"name": "cancelExpiration", "type": "uint256", "indexed": false},
+    {"name": "dataVersion", "type": "uint256", "indexed": false},
+    {"name": "data", "type": "bytes", "indexed": false}
+  ]}
+]`
+
+var contracts abi.ABI
+
+var (
+	// AnswerUpdatedTopic is the topic of the FluxAggregator AnswerUpdated event
+	AnswerUpdatedTopic common.Hash
+	// NewRoundTopic is the topic of the FluxAggregator NewRound event
+	NewRoundTopic common.Hash
+	// SubmissionReceivedTopic is the topic of the FluxAggregator SubmissionReceived event
+	SubmissionReceivedTopic common.Hash
+	// OracleRequestTopic is the topic of the Oracle OracleRequest event
+	OracleRequestTopic common.Hash
+)
+
+func init() {
+	var err error
+	contracts, err = abi.JSON(strings.NewReader(contractsABI))
+	if err != nil {
+		panic(err)
+	}
+	AnswerUpdatedTopic = contracts.Events["AnswerUpdated"].ID()
+	NewRoundTopic = contracts.Events["NewRound"].ID()
+	SubmissionReceivedTopic = contracts.Events["SubmissionReceived"].ID()
+	OracleRequestTopic = contracts.Events["OracleRequest"].ID()
+}
+
+// EventTopics returns the topics of every event the ingester decodes
+func EventTopics() []common.Hash {
+	return []common.Hash{
+		AnswerUpdatedTopic,
+		NewRoundTopic,
+		SubmissionReceivedTopic,
+		OracleRequestTopic,
+	}
+}
+
+// AnswerUpdated is emitted by a FluxAggregator when a round is answered
+type AnswerUpdated struct {
+	Current   *big.Int
+	RoundID   *big.Int
+	Timestamp *big.Int
+}
+
+// NewRound is emitted by a FluxAggregator when a round is started
+type NewRound struct {
+	RoundID   *big.Int
+	StartedBy common.Address
+	StartedAt *big.Int
+}
+
+// SubmissionReceived is emitted by a FluxAggregator for each oracle submission
+type SubmissionReceived struct {
+	Submission *big.Int
+	Round      uint32
+	Oracle     common.Address
+}
+
+// OracleRequest is emitted by an Oracle contract for each Chainlink request
+type OracleRequest struct {
+	SpecID             common.Hash
+	Requester          common.Address
+	RequestID          common.Hash
+	Payment            *big.Int
+	CallbackAddr       common.Address
+	CallbackFunctionID [4]byte
+	CancelExpiration   *big.Int
+	DataVersion        *big.Int
+	Data               []byte
+}
+
+// DecodeLog decodes a log of one of the known events into its type, and
+// returns nil if the log is not from a known event
+func DecodeLog(log types.Log) (interface{}, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil
+	}
+
+	var name string
+	switch log.Topics[0] {
+	case AnswerUpdatedTopic:
+		name = "AnswerUpdated"
+	case NewRoundTopic:
+		name = "NewRound"
+	case SubmissionReceivedTopic:
+		name = "SubmissionReceived"
+	case OracleRequestTopic:
+		name = "OracleRequest"
+	default:
+		return nil, nil
+	}
+
+	event := contracts.Events[name]
+	indexed := len(event.Inputs) - len(event.Inputs.NonIndexed())
+	if len(log.Topics) != indexed+1 {
+		return nil, fmt.Errorf("%s log has %d topics, expected %d", name, len(log.Topics), indexed+1)
+	}
+	values, err := event.Inputs.NonIndexed().UnpackValues(log.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unpacking %s log", name)
+	}
+
+	topics := log.Topics[1:]
+	switch log.Topics[0] {
+	case AnswerUpdatedTopic:
+		return AnswerUpdated{
+			Current:   math.S256(topics[0].Big()),
+			RoundID:   topics[1].Big(),
+			Timestamp: values[0].(*big.Int),
+		}, nil
+	case NewRoundTopic:
+		return NewRound{
+			RoundID:   topics[0].Big(),
+			StartedBy: common.BytesToAddress(topics[1].Bytes()),
+			StartedAt: values[0].(*big.Int),
+		}, nil
+	case SubmissionReceivedTopic:
+		return SubmissionReceived{
+			Submission: math.S256(topics[0].Big()),
+			Round:      uint32(topics[1].Big().Uint64()),
+			Oracle:     common.BytesToAddress(topics[2].Bytes()),
+		}, nil
+	default:
+		return OracleRequest{
+			SpecID:             topics[0],
+			Requester:          values[0].(common.Address),
+			RequestID:          common.Hash(values[1].([32]byte)),
+			Payment:            values[2].(*big.Int),
+			CallbackAddr:       values[3].(common.Address),
+			CallbackFunctionID: values[4].([4]byte),
+			CancelExpiration:   values[5].(*big.Int),
+			DataVersion:        values[6].(*big.Int),
+			Data:               values[7].([]byte),
+		}, nil
+	}
+}
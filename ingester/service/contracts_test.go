@@ -0,0 +1,98 @@
+package service
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeLog_AnswerUpdated(t *testing.T) {
+	data, err := contracts.Events["AnswerUpdated"].Inputs.NonIndexed().Pack(big.NewInt(1590000000))
+	require.NoError(t, err)
+
+	event, err := DecodeLog(types.Log{
+		Topics: []common.Hash{
+			AnswerUpdatedTopic,
+			common.BigToHash(math.U256(big.NewInt(-42))),
+			common.BigToHash(big.NewInt(7)),
+		},
+		Data: data,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, AnswerUpdated{
+		Current:   big.NewInt(-42),
+		RoundID:   big.NewInt(7),
+		Timestamp: big.NewInt(1590000000),
+	}, event)
+}
+
+func TestDecodeLog_SubmissionReceived(t *testing.T) {
+	oracle := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+
+	event, err := DecodeLog(types.Log{
+		Topics: []common.Hash{
+			SubmissionReceivedTopic,
+			common.BigToHash(big.NewInt(100)),
+			common.BigToHash(big.NewInt(3)),
+			oracle.Hash(),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, SubmissionReceived{
+		Submission: big.NewInt(100),
+		Round:      3,
+		Oracle:     oracle,
+	}, event)
+}
+
+func TestDecodeLog_OracleRequest(t *testing.T) {
+	requester := common.HexToAddress("0x9FBDa871d559710256a2502A2517b794B482Db40")
+	requestID := common.HexToHash("0x01")
+	data, err := contracts.Events["OracleRequest"].Inputs.NonIndexed().Pack(
+		requester,
+		[32]byte(requestID),
+		big.NewInt(1e18),
+		requester,
+		[4]byte{1, 2, 3, 4},
+		big.NewInt(1590000300),
+		big.NewInt(1),
+		[]byte("cbor"),
+	)
+	require.NoError(t, err)
+
+	specID := common.HexToHash("0x4c7b7ffb66b344fbaa64995af81e355a")
+	event, err := DecodeLog(types.Log{
+		Topics: []common.Hash{OracleRequestTopic, specID},
+		Data:   data,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, OracleRequest{
+		SpecID:             specID,
+		Requester:          requester,
+		RequestID:          requestID,
+		Payment:            big.NewInt(1e18),
+		CallbackAddr:       requester,
+		CallbackFunctionID: [4]byte{1, 2, 3, 4},
+		CancelExpiration:   big.NewInt(1590000300),
+		DataVersion:        big.NewInt(1),
+		Data:               []byte("cbor"),
+	}, event)
+}
+
+func TestDecodeLog_UnknownOrMalformed(t *testing.T) {
+	event, err := DecodeLog(types.Log{Topics: []common.Hash{common.HexToHash("0x01")}})
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+
+	event, err = DecodeLog(types.Log{})
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+
+	_, err = DecodeLog(types.Log{Topics: []common.Hash{NewRoundTopic}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Round is a round of a FluxAggregator with its latest answer, if any
+type Round struct {
+	RoundID   uint64         `json:"roundId"`
+	StartedBy common.Address `json:"startedBy"`
+	StartedAt int64          `json:"startedAt"`
+	// Answer and UpdatedAt are nil if the round was never answered
+	Answer      *string     `json:"answer"`
+	UpdatedAt   *int64      `json:"updatedAt"`
+	Submissions int64       `json:"submissions"`
+	BlockNumber uint64      `json:"blockNumber"`
+	TxHash      common.Hash `json:"txHash"`
+}
+
+// OracleStats sums up the submissions of an oracle to a FluxAggregator
+type OracleStats struct {
+	Oracle       common.Address `json:"oracle"`
+	Submissions  int64          `json:"submissions"`
+	Rounds       int64          `json:"rounds"`
+	MissedRounds int64          `json:"missedRounds"`
+	// FirstRound and LastRound are nil if the oracle submitted to none of
+	// the rounds
+	FirstRound *uint64 `json:"firstRound"`
+	LastRound  *uint64 `json:"lastRound"`
+}
+
+// RoundGap is a range of round IDs missing between two ingested rounds
+type RoundGap struct {
+	AfterRound  uint64 `json:"afterRound"`
+	BeforeRound uint64 `json:"beforeRound"`
+	Missing     uint64 `json:"missing"`
+}
+
+// LateRound is a round whose first answer came later than expected after it
+// started, or that is still unanswered past that delay
+type LateRound struct {
+	RoundID   uint64 `json:"roundId"`
+	StartedAt int64  `json:"startedAt"`
+	// AnsweredAt is nil if the round was never answered
+	AnsweredAt *int64 `json:"answeredAt"`
+	// Delay is the number of seconds between the start of the round and its
+	// first answer, or until now if it was never answered
+	Delay int64 `json:"delay"`
+}
+
+// Rounds returns the rounds of an aggregator started within the time range,
+// most recent first
+func (s *store) Rounds(aggregator common.Address, from, to time.Time, limit int) ([]Round, error) {
+	rows, err := s.db.Query(`
+		SELECT nr."roundId"::bigint, nr."startedBy", nr."startedAt"::bigint, au."current"::text, au."timestamp"::bigint,
+			(SELECT COUNT(*) FROM "flux_aggregator_submission_received" sr
+				WHERE sr."address" = nr."address" AND sr."round" = nr."roundId" AND NOT sr."orphaned"),
+			nr."blockNumber", nr."txHash"
+		FROM "flux_aggregator_new_round" nr
+		LEFT JOIN LATERAL (
+			SELECT "current", "timestamp" FROM "flux_aggregator_answer_updated"
+			WHERE "address" = nr."address" AND "roundId" = nr."roundId" AND NOT "orphaned"
+			ORDER BY "blockNumber" DESC, "logIndex" DESC LIMIT 1
+		) au ON TRUE
+		WHERE nr."address" = $1 AND NOT nr."orphaned" AND nr."startedAt" >= $2 AND nr."startedAt" < $3
+		ORDER BY nr."roundId" DESC
+		LIMIT $4;`,
+		aggregator.Bytes(), from.Unix(), to.Unix(), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading rounds")
+	}
+	defer rows.Close()
+
+	rounds := []Round{}
+	for rows.Next() {
+		var (
+			round     Round
+			startedBy []byte
+			txHash    []byte
+			answer    sql.NullString
+			updatedAt sql.NullInt64
+		)
+		err := rows.Scan(&round.RoundID, &startedBy, &round.StartedAt, &answer, &updatedAt, &round.Submissions, &round.BlockNumber, &txHash)
+		if err != nil {
+			return nil, err
+		}
+		round.StartedBy = common.BytesToAddress(startedBy)
+		round.TxHash = common.BytesToHash(txHash)
+		if answer.Valid {
+			round.Answer = &answer.String
+		}
+		if updatedAt.Valid {
+			round.UpdatedAt = &updatedAt.Int64
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds, rows.Err()
+}
+
+// OracleStats returns the submissions of each oracle to the rounds of an
+// aggregator started within the time range. The oracles are those that ever
+// submitted to the aggregator, so that one missing every round is included.
+func (s *store) OracleStats(aggregator common.Address, from, to time.Time) ([]OracleStats, error) {
+	var total int64
+	err := s.db.QueryRow(`
+		SELECT COUNT(*) FROM "flux_aggregator_new_round"
+		WHERE "address" = $1 AND NOT "orphaned" AND "startedAt" >= $2 AND "startedAt" < $3;`,
+		aggregator.Bytes(), from.Unix(), to.Unix()).Scan(&total)
+	if err != nil {
+		return nil, errors.Wrap(err, "counting rounds")
+	}
+
+	rows, err := s.db.Query(`
+		SELECT o."oracle", COUNT(s."round"), COUNT(DISTINCT s."round"), MIN(s."round"), MAX(s."round")
+		FROM (
+			SELECT DISTINCT "oracle" FROM "flux_aggregator_submission_received"
+			WHERE "address" = $1 AND NOT "orphaned"
+		) o
+		LEFT JOIN (
+			SELECT sr."oracle", sr."round"
+			FROM "flux_aggregator_submission_received" sr
+			JOIN "flux_aggregator_new_round" nr
+				ON nr."address" = sr."address" AND nr."roundId" = sr."round" AND NOT nr."orphaned"
+			WHERE sr."address" = $1 AND NOT sr."orphaned" AND nr."startedAt" >= $2 AND nr."startedAt" < $3
+		) s ON s."oracle" = o."oracle"
+		GROUP BY o."oracle"
+		ORDER BY COUNT(s."round") DESC, o."oracle";`,
+		aggregator.Bytes(), from.Unix(), to.Unix())
+	if err != nil {
+		return nil, errors.Wrap(err, "loading oracle stats")
+	}
+	defer rows.Close()
+
+	stats := []OracleStats{}
+	for rows.Next() {
+		var (
+			oracle      []byte
+			oracleStats OracleStats
+			first, last sql.NullInt64
+		)
+		err := rows.Scan(&oracle, &oracleStats.Submissions, &oracleStats.Rounds, &first, &last)
+		if err != nil {
+			return nil, err
+		}
+		oracleStats.Oracle = common.BytesToAddress(oracle)
+		if first.Valid && last.Valid {
+			firstRound, lastRound := uint64(first.Int64), uint64(last.Int64)
+			oracleStats.FirstRound, oracleStats.LastRound = &firstRound, &lastRound
+		}
+		oracleStats.MissedRounds = total - oracleStats.Rounds
+		stats = append(stats, oracleStats)
+	}
+	return stats, rows.Err()
+}
+
+// RoundGaps returns the round IDs missing between the rounds of an aggregator
+// started within the time range
+func (s *store) RoundGaps(aggregator common.Address, from, to time.Time) ([]RoundGap, error) {
+	rows, err := s.db.Query(`
+		SELECT "previous", "roundId" FROM (
+			SELECT "roundId"::bigint, LAG("roundId"::bigint) OVER (ORDER BY "roundId") AS "previous"
+			FROM "flux_aggregator_new_round"
+			WHERE "address" = $1 AND NOT "orphaned" AND "startedAt" >= $2 AND "startedAt" < $3
+		) r
+		WHERE "roundId" - "previous" > 1
+		ORDER BY "roundId";`,
+		aggregator.Bytes(), from.Unix(), to.Unix())
+	if err != nil {
+		return nil, errors.Wrap(err, "loading round gaps")
+	}
+	defer rows.Close()
+
+	gaps := []RoundGap{}
+	for rows.Next() {
+		var gap RoundGap
+		if err := rows.Scan(&gap.AfterRound, &gap.BeforeRound); err != nil {
+			return nil, err
+		}
+		gap.Missing = gap.BeforeRound - gap.AfterRound - 1
+		gaps = append(gaps, gap)
+	}
+	return gaps, rows.Err()
+}
+
+// LateRounds returns the rounds of an aggregator started within the time
+// range whose first answer came more than lateAfter after they started, or
+// that are unanswered lateAfter after they started
+func (s *store) LateRounds(aggregator common.Address, from, to time.Time, lateAfter time.Duration) ([]LateRound, error) {
+	now := time.Now().Unix()
+	rows, err := s.db.Query(`
+		SELECT nr."roundId"::bigint, nr."startedAt"::bigint, au."timestamp"::bigint
+		FROM "flux_aggregator_new_round" nr
+		LEFT JOIN LATERAL (
+			SELECT "timestamp" FROM "flux_aggregator_answer_updated"
+			WHERE "address" = nr."address" AND "roundId" = nr."roundId" AND NOT "orphaned"
+			ORDER BY "timestamp", "blockNumber", "logIndex" LIMIT 1
+		) au ON TRUE
+		WHERE nr."address" = $1 AND NOT nr."orphaned" AND nr."startedAt" >= $2 AND nr."startedAt" < $3
+			AND COALESCE(au."timestamp", $5) - nr."startedAt" > $4
+		ORDER BY nr."roundId";`,
+		aggregator.Bytes(), from.Unix(), to.Unix(), int64(lateAfter/time.Second), now)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading late rounds")
+	}
+	defer rows.Close()
+
+	late := []LateRound{}
+	for rows.Next() {
+		var (
+			round      LateRound
+			answeredAt sql.NullInt64
+		)
+		if err := rows.Scan(&round.RoundID, &round.StartedAt, &answeredAt); err != nil {
+			return nil, err
+		}
+		if answeredAt.Valid {
+			round.AnsweredAt = &answeredAt.Int64
+			round.Delay = answeredAt.Int64 - round.StartedAt
+		} else {
+			round.Delay = now - round.StartedAt
+		}
+		late = append(late, round)
+	}
+	return late, rows.Err()
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	feedsAggregator = common.HexToAddress("0x79fEbF6B9F76853EDBcBc913e6aAE8232cFB9De9")
+	feedsOracleA    = common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	feedsOracleB    = common.HexToAddress("0x9FBDa871d559710256a2502A2517b794B482Db40")
+	feedsOracleC    = common.HexToAddress("0xD2c6b2A46e85A4Ddd9A8A1bAbc1F0fDA3D0e8eF2")
+	feedsFrom       = time.Unix(1000, 0)
+	feedsTo         = time.Unix(2000, 0)
+)
+
+// newFeedsStore returns a store holding the rounds 0 to 6 of an aggregator,
+// of which rounds 1, 2 and 5 started within the feeds time range. Round 3 was
+// started by another aggregator, and round 6 in an orphaned block. Oracle C
+// only submitted to round 0.
+func newFeedsStore(t *testing.T) (*store, func()) {
+	st, cleanup := newTestStore(t)
+
+	other := common.HexToAddress("0x2a7B8D3C7D3A1f7a0c7E0A9D9bB0C84a3e27F5d3")
+	orphaned := newRoundLog(t, feedsAggregator, 16, 0, 6, 1500)
+	orphaned.Removed = true
+	logs := []types.Log{
+		newRoundLog(t, feedsAggregator, 9, 0, 0, 500),
+		newRoundLog(t, feedsAggregator, 10, 0, 1, 1000),
+		newRoundLog(t, feedsAggregator, 11, 0, 2, 1100),
+		newRoundLog(t, other, 13, 0, 3, 1200),
+		newRoundLog(t, feedsAggregator, 14, 0, 5, 1400),
+		orphaned,
+
+		answerUpdatedLog(t, feedsAggregator, 10, 1, 100, 1, 1030),
+		answerUpdatedLog(t, feedsAggregator, 12, 0, 101, 1, 1200),
+		answerUpdatedLog(t, feedsAggregator, 13, 1, 200, 2, 1300),
+
+		submissionLog(feedsAggregator, 9, 1, 50, 0, feedsOracleC),
+		submissionLog(feedsAggregator, 10, 2, 100, 1, feedsOracleA),
+		submissionLog(feedsAggregator, 10, 3, 100, 1, feedsOracleB),
+		submissionLog(feedsAggregator, 11, 1, 200, 2, feedsOracleA),
+		submissionLog(feedsAggregator, 14, 1, 500, 5, feedsOracleA),
+	}
+	for _, log := range logs {
+		if err := st.SaveLog(log); err != nil {
+			cleanup()
+			require.NoError(t, err)
+		}
+	}
+	return st, cleanup
+}
+
+func TestStore_Rounds(t *testing.T) {
+	st, cleanup := newFeedsStore(t)
+	defer cleanup()
+
+	rounds, err := st.Rounds(feedsAggregator, feedsFrom, feedsTo, 10)
+	require.NoError(t, err)
+	require.Len(t, rounds, 3)
+
+	assert.Equal(t, uint64(5), rounds[0].RoundID)
+	assert.Nil(t, rounds[0].Answer)
+	assert.Nil(t, rounds[0].UpdatedAt)
+	assert.Equal(t, int64(1), rounds[0].Submissions)
+	assert.Equal(t, uint64(14), rounds[0].BlockNumber)
+
+	assert.Equal(t, uint64(2), rounds[1].RoundID)
+	require.NotNil(t, rounds[1].Answer)
+	assert.Equal(t, "200", *rounds[1].Answer)
+
+	assert.Equal(t, uint64(1), rounds[2].RoundID)
+	assert.Equal(t, int64(1000), rounds[2].StartedAt)
+	assert.Equal(t, common.HexToAddress("0x01"), rounds[2].StartedBy)
+	require.NotNil(t, rounds[2].Answer)
+	assert.Equal(t, "101", *rounds[2].Answer)
+	require.NotNil(t, rounds[2].UpdatedAt)
+	assert.Equal(t, int64(1200), *rounds[2].UpdatedAt)
+	assert.Equal(t, int64(2), rounds[2].Submissions)
+
+	rounds, err = st.Rounds(feedsAggregator, feedsFrom, feedsTo, 2)
+	require.NoError(t, err)
+	require.Len(t, rounds, 2)
+	assert.Equal(t, uint64(5), rounds[0].RoundID)
+	assert.Equal(t, uint64(2), rounds[1].RoundID)
+}
+
+func TestStore_OracleStats(t *testing.T) {
+	st, cleanup := newFeedsStore(t)
+	defer cleanup()
+
+	stats, err := st.OracleStats(feedsAggregator, feedsFrom, feedsTo)
+	require.NoError(t, err)
+
+	first, last, only := uint64(1), uint64(5), uint64(1)
+	assert.Equal(t, []OracleStats{
+		{Oracle: feedsOracleA, Submissions: 3, Rounds: 3, MissedRounds: 0, FirstRound: &first, LastRound: &last},
+		{Oracle: feedsOracleB, Submissions: 1, Rounds: 1, MissedRounds: 2, FirstRound: &only, LastRound: &only},
+		{Oracle: feedsOracleC, Submissions: 0, Rounds: 0, MissedRounds: 3},
+	}, stats)
+}
+
+func TestStore_RoundGaps(t *testing.T) {
+	st, cleanup := newFeedsStore(t)
+	defer cleanup()
+
+	gaps, err := st.RoundGaps(feedsAggregator, feedsFrom, feedsTo)
+	require.NoError(t, err)
+	assert.Equal(t, []RoundGap{{AfterRound: 2, BeforeRound: 5, Missing: 2}}, gaps)
+
+	gaps, err = st.RoundGaps(feedsAggregator, feedsFrom, time.Unix(1400, 0))
+	require.NoError(t, err)
+	assert.Empty(t, gaps)
+}
+
+func TestStore_LateRounds(t *testing.T) {
+	st, cleanup := newFeedsStore(t)
+	defer cleanup()
+
+	late, err := st.LateRounds(feedsAggregator, feedsFrom, feedsTo, 100*time.Second)
+	require.NoError(t, err)
+	require.Len(t, late, 2)
+
+	answeredAt := int64(1300)
+	assert.Equal(t, LateRound{RoundID: 2, StartedAt: 1100, AnsweredAt: &answeredAt, Delay: 200}, late[0])
+
+	assert.Equal(t, uint64(5), late[1].RoundID)
+	assert.Nil(t, late[1].AnsweredAt)
+	assert.True(t, late[1].Delay >= time.Now().Unix()-1400)
+
+	late, err = st.LateRounds(feedsAggregator, feedsFrom, time.Unix(1400, 0), 300*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, late)
+}
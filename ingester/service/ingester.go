@@ -0,0 +1,274 @@
+package service
+
+import (
+	"math/big"
+	"time"
+
+	"ingester/client"
+	"ingester/logger"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ingester saves the logs of the known events and the heads of the chain,
+// and keeps track of which blocks are still canonical
+type ingester struct {
+	config *Config
+	eth    client.ETH
+	store  *store
+
+	logSub  client.Subscription
+	headSub client.Subscription
+	// backfilled is closed once the logs missed since the stored cursor are
+	// saved, after which new heads move the cursor
+	backfilled chan struct{}
+	chStop     chan struct{}
+}
+
+// The backfill is retried after a delay that doubles up to the maximum
+var (
+	backfillRetryMinDelay = time.Second
+	backfillRetryMaxDelay = time.Minute
+)
+
+// FilterQuery returns the filter for the logs of the known events, restricted
+// to the configured contracts if there are any
+func (i *ingester) FilterQuery() ethereum.FilterQuery {
+	q := ethereum.FilterQuery{Topics: [][]common.Hash{EventTopics()}}
+	for _, address := range i.config.ContractAddresses {
+		if address != "" {
+			q.Addresses = append(q.Addresses, common.HexToAddress(address))
+		}
+	}
+	return q
+}
+
+// Start subscribes to logs and heads, and backfills the logs missed since the
+// stored cursor before handling new ones
+func (i *ingester) Start() error {
+	i.backfilled = make(chan struct{})
+	i.chStop = make(chan struct{})
+	logChan := make(chan types.Log)
+	logSub, err := i.eth.SubscribeToLogs(logChan, i.FilterQuery())
+	if err != nil {
+		return err
+	}
+	i.logSub = logSub
+
+	headChan := make(chan types.Header)
+	headSub, err := i.eth.SubscribeToNewHeads(headChan)
+	if err != nil {
+		logSub.Unsubscribe()
+		return err
+	}
+	i.headSub = headSub
+
+	go func() {
+		if !i.backfillUntilDone() {
+			return
+		}
+
+		logger.Debug("Listening for logs")
+		for log := range logChan {
+			i.HandleLog(log)
+		}
+	}()
+
+	go func() {
+		logger.Debug("Listening for heads")
+		for head := range headChan {
+			i.HandleHead(head)
+		}
+	}()
+
+	return nil
+}
+
+// Stop unsubscribes from logs and heads
+func (i *ingester) Stop() {
+	if i.chStop != nil {
+		close(i.chStop)
+	}
+	if i.logSub != nil {
+		i.logSub.Unsubscribe()
+	}
+	if i.headSub != nil {
+		i.headSub.Unsubscribe()
+	}
+}
+
+// backfillUntilDone retries the backfill until it succeeds, and then closes
+// backfilled. It returns false if the ingester stopped first.
+func (i *ingester) backfillUntilDone() bool {
+	delay := backfillRetryMinDelay
+	for {
+		err := i.Backfill()
+		if err == nil {
+			close(i.backfilled)
+			return true
+		}
+		logger.Errorw("Backfill failed, retrying", "delay", delay, "error", err)
+
+		select {
+		case <-time.After(delay):
+		case <-i.chStop:
+			return false
+		}
+		if delay *= 2; delay > backfillRetryMaxDelay {
+			delay = backfillRetryMaxDelay
+		}
+	}
+}
+
+// Backfill saves the logs after the stored cursor up to the latest block.
+// Without a cursor, ingestion starts from the latest block.
+func (i *ingester) Backfill() error {
+	latest, err := i.eth.BlockNumber()
+	if err != nil {
+		return err
+	}
+	cursor, ok, err := i.store.Cursor(i.config.NetworkID)
+	if err != nil {
+		return err
+	}
+	from := latest
+	if ok {
+		from = cursor + 1
+	}
+	logger.Infow("Backfilling logs", "fromBlock", from, "toBlock", latest)
+	return i.ingest(from, latest)
+}
+
+// ingest saves the logs of the blocks in the range, in batches of at most
+// BackfillBatchSize blocks, and moves the cursor past each batch once its
+// logs are saved.
+func (i *ingester) ingest(from, to uint64) error {
+	batchSize := i.config.BackfillBatchSize
+	if batchSize == 0 {
+		batchSize = 1
+	}
+	for start := from; start <= to; start += batchSize {
+		end := start + batchSize - 1
+		if end > to {
+			end = to
+		}
+		q := i.FilterQuery()
+		q.FromBlock = new(big.Int).SetUint64(start)
+		q.ToBlock = new(big.Int).SetUint64(end)
+		logs, err := i.eth.FilterLogs(q)
+		if err != nil {
+			return err
+		}
+		for _, log := range logs {
+			if err := i.store.SaveLog(log); err != nil {
+				return err
+			}
+		}
+		if err := i.store.SaveCursor(i.config.NetworkID, end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleLog saves a log
+func (i *ingester) HandleLog(log types.Log) {
+	logger.Debugw("Observed new log", "blockHash", log.BlockHash, "index", log.Index, "removed", log.Removed)
+	if err := i.store.SaveLog(log); err != nil {
+		logger.Errorw("Insert failed", "error", err)
+	}
+}
+
+// HandleHead saves a head, moves the cursor up to its parent once the
+// backfill is done, and orphans the blocks it replaces. The logs of the
+// blocks the cursor passes are fetched and saved first, so that it only
+// passes blocks whose logs are saved, even those the subscription missed.
+// The cursor stays one block behind, as logs of the head may arrive after
+// it. Stored heads at or above its height that differ from it are orphaned,
+// then its ancestors are fetched and saved until one matches the stored
+// chain, orphaning the stored heads they replace.
+func (i *ingester) HandleHead(head types.Header) {
+	logger.Debugw("Observed new head", "blockHeight", head.Number, "blockHash", head.Hash())
+	if err := i.store.SaveHead(&head); err != nil {
+		logger.Errorw("Insert failed", "error", err)
+		return
+	}
+	if i.isBackfilled() && head.Number.Sign() > 0 {
+		if err := i.ingestUpTo(head.Number.Uint64() - 1); err != nil {
+			logger.Errorw("Ingesting logs up to head failed", "error", err)
+		}
+	}
+
+	replaced, err := i.store.CanonicalHeads(head.Number.Uint64(), true)
+	if err != nil {
+		logger.Errorw("Reorg check failed", "error", err)
+		return
+	}
+	i.orphan(replaced, head.Hash())
+
+	current := &head
+	for current.Number.Sign() > 0 {
+		replaced, err := i.store.CanonicalHeads(current.Number.Uint64()-1, false)
+		if err != nil {
+			logger.Errorw("Reorg check failed", "error", err)
+			return
+		}
+		if len(replaced) == 0 || !i.orphan(replaced, current.ParentHash) {
+			return
+		}
+
+		parent, err := i.eth.HeaderByHash(current.ParentHash)
+		if err != nil {
+			logger.Errorw("Fetching reorged block failed", "blockHash", current.ParentHash, "error", err)
+			return
+		}
+		if err := i.store.SaveHead(parent); err != nil {
+			logger.Errorw("Insert failed", "error", err)
+			return
+		}
+		current = parent
+	}
+}
+
+// ingestUpTo saves the logs of the blocks after the cursor up to the given
+// one, moving the cursor to it.
+func (i *ingester) ingestUpTo(blockNumber uint64) error {
+	cursor, ok, err := i.store.Cursor(i.config.NetworkID)
+	if err != nil {
+		return err
+	}
+	from := blockNumber
+	if ok {
+		from = cursor + 1
+	}
+	return i.ingest(from, blockNumber)
+}
+
+func (i *ingester) isBackfilled() bool {
+	select {
+	case <-i.backfilled:
+		return true
+	default:
+		return false
+	}
+}
+
+// orphan orphans the given blocks except the canonical one, and returns
+// false if there was none to orphan or it failed
+func (i *ingester) orphan(hashes []common.Hash, canonical common.Hash) bool {
+	orphaned := false
+	for _, hash := range hashes {
+		if hash == canonical {
+			continue
+		}
+		logger.Infow("Orphaning block replaced by a reorg", "blockHash", hash)
+		if err := i.store.OrphanBlock(hash); err != nil {
+			logger.Errorw("Orphaning block failed", "blockHash", hash, "error", err)
+			return false
+		}
+		orphaned = true
+	}
+	return orphaned
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"ingester/client"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeETH serves the given headers and logs, failing the first filterErrors
+// calls to FilterLogs
+type fakeETH struct {
+	client.ETH
+	latest       uint64
+	headers      []*types.Header
+	logs         []types.Log
+	filterErrors int
+	queries      [][2]uint64
+}
+
+func (f *fakeETH) BlockNumber() (uint64, error) {
+	return f.latest, nil
+}
+
+func (f *fakeETH) FilterLogs(q ethereum.FilterQuery) ([]types.Log, error) {
+	if f.filterErrors > 0 {
+		f.filterErrors--
+		return nil, errors.New("eth_getLogs failed")
+	}
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	f.queries = append(f.queries, [2]uint64{from, to})
+
+	var logs []types.Log
+	for _, log := range f.logs {
+		if log.BlockNumber >= from && log.BlockNumber <= to {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (f *fakeETH) HeaderByHash(hash common.Hash) (*types.Header, error) {
+	for _, header := range f.headers {
+		if header.Hash() == hash {
+			return header, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+// testHeader returns a header whose hash differs between forks
+func testHeader(number int64, parent common.Hash, fork string) *types.Header {
+	return &types.Header{
+		ParentHash: parent,
+		Difficulty: big.NewInt(1),
+		Number:     big.NewInt(number),
+		Extra:      []byte(fork),
+	}
+}
+
+func newTestIngester(st *store, eth *fakeETH) *ingester {
+	config := TestConfig()
+	config.BackfillBatchSize = 2
+	return &ingester{config: config, eth: eth, store: st}
+}
+
+func TestIngester_HandleHead_IngestsLogsUpToParent(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	aggregator := common.HexToAddress("0x79fEbF6B9F76853EDBcBc913e6aAE8232cFB9De9")
+	eth := &fakeETH{logs: []types.Log{
+		newRoundLog(t, aggregator, 11, 0, 1, 1590000000),
+		newRoundLog(t, aggregator, 13, 0, 2, 1590000100),
+		newRoundLog(t, aggregator, 14, 0, 3, 1590000200),
+	}}
+	ing := newTestIngester(st, eth)
+	ing.backfilled = make(chan struct{})
+	require.NoError(t, st.SaveCursor(ing.config.NetworkID, 10))
+
+	ing.HandleHead(*testHeader(14, common.Hash{}, "a"))
+	cursor, _, err := st.Cursor(ing.config.NetworkID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), cursor, "cursor moved before the backfill")
+	assert.Empty(t, eth.queries)
+
+	close(ing.backfilled)
+	ing.HandleHead(*testHeader(14, common.Hash{}, "a"))
+	cursor, _, err = st.Cursor(ing.config.NetworkID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(13), cursor)
+	assert.Equal(t, [][2]uint64{{11, 12}, {13, 13}}, eth.queries)
+	assert.Equal(t, 2, queryCount(t, st, `SELECT COUNT(*) FROM "flux_aggregator_new_round";`))
+}
+
+func TestIngester_HandleHead_OrphansReorgedBlocks(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	head1 := testHeader(1, common.Hash{}, "a")
+	head2a := testHeader(2, head1.Hash(), "a")
+	head3a := testHeader(3, head2a.Hash(), "a")
+	head2b := testHeader(2, head1.Hash(), "b")
+	head3b := testHeader(3, head2b.Hash(), "b")
+	for _, head := range []*types.Header{head1, head2a, head3a} {
+		require.NoError(t, st.SaveHead(head))
+	}
+	aggregator := common.HexToAddress("0x79fEbF6B9F76853EDBcBc913e6aAE8232cFB9De9")
+	log := newRoundLog(t, aggregator, 2, 0, 1, 1590000000)
+	log.BlockHash = head2a.Hash()
+	require.NoError(t, st.SaveLog(log))
+
+	ing := newTestIngester(st, &fakeETH{headers: []*types.Header{head2b}})
+	ing.HandleHead(*head3b)
+
+	orphaned := `SELECT "orphaned" FROM "ethereum_head" WHERE "blockHash" = $1;`
+	assert.False(t, queryBool(t, st, orphaned, head1.Hash().Bytes()))
+	assert.True(t, queryBool(t, st, orphaned, head2a.Hash().Bytes()))
+	assert.True(t, queryBool(t, st, orphaned, head3a.Hash().Bytes()))
+	assert.False(t, queryBool(t, st, orphaned, head2b.Hash().Bytes()))
+	assert.False(t, queryBool(t, st, orphaned, head3b.Hash().Bytes()))
+	assert.True(t, queryBool(t, st, `SELECT "removed" FROM "ethereum_log";`))
+	assert.True(t, queryBool(t, st, `SELECT "orphaned" FROM "flux_aggregator_new_round";`))
+
+	for number, want := range []*types.Header{head1, head2b, head3b} {
+		hashes, err := st.CanonicalHeads(uint64(number+1), false)
+		require.NoError(t, err)
+		assert.Equal(t, []common.Hash{want.Hash()}, hashes)
+	}
+}
+
+func TestIngester_Backfill_RetriesUntilDone(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	defer func(delay time.Duration) { backfillRetryMinDelay = delay }(backfillRetryMinDelay)
+	backfillRetryMinDelay = time.Millisecond
+
+	eth := &fakeETH{latest: 14, filterErrors: 2}
+	ing := newTestIngester(st, eth)
+	ing.backfilled = make(chan struct{})
+	ing.chStop = make(chan struct{})
+	require.NoError(t, st.SaveCursor(ing.config.NetworkID, 10))
+
+	assert.True(t, ing.backfillUntilDone())
+	assert.True(t, ing.isBackfilled())
+	assert.Equal(t, [][2]uint64{{11, 12}, {13, 14}}, eth.queries)
+	cursor, _, err := st.Cursor(ing.config.NetworkID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(14), cursor)
+
+	backfillRetryMinDelay = time.Hour
+	eth.filterErrors = 1
+	eth.latest = 15
+	ing.backfilled = make(chan struct{})
+	close(ing.chStop)
+	assert.False(t, ing.backfillUntilDone())
+	assert.False(t, ing.isBackfilled())
+}
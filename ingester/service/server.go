@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"ingester/logger"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	defaultRoundsLimit = 100
+	maxRoundsLimit     = 1000
+)
+
+// server serves the history of the ingested aggregators over HTTP
+type server struct {
+	config *Config
+	store  *store
+	http   *http.Server
+}
+
+func newServer(config *Config, store *store) *server {
+	s := &server{config: config, store: store}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/aggregators/", s.aggregators)
+	s.http = &http.Server{
+		Addr:    fmt.Sprintf(":%d", config.HTTPPort),
+		Handler: mux,
+	}
+	return s
+}
+
+// Start listens for requests in the background
+func (s *server) Start() {
+	go func() {
+		logger.Infow("Serving the ingester API", "port", s.config.HTTPPort)
+		if err := s.http.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Errorw("Ingester API failed", "error", err)
+		}
+	}()
+}
+
+// Stop waits for the requests in progress to finish
+func (s *server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.http.Shutdown(ctx); err != nil {
+		logger.Errorw("Stopping the ingester API failed", "error", err)
+	}
+}
+
+// aggregators routes requests under /aggregators/:address/:resource
+//
+// Example:
+//  "<application>/aggregators/0x79fEbF6B9F76853EDBcBc913e6aAE8232cFB9De9/rounds?from=1590000000&to=1590086400"
+func (s *server) aggregators(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/aggregators/"), "/"), "/")
+	if len(parts) != 2 {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no route for %s", r.URL.Path))
+		return
+	}
+	if !common.IsHexAddress(parts[0]) {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid aggregator address %s", parts[0]))
+		return
+	}
+	aggregator := common.HexToAddress(parts[0])
+
+	from, to, err := parseTimeRange(r, time.Now())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	switch parts[1] {
+	case "rounds":
+		limit, err := parseLimit(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		rounds, err := s.store.Rounds(aggregator, from, to, limit)
+		writeResult(w, rounds, err)
+	case "oracles":
+		stats, err := s.store.OracleStats(aggregator, from, to)
+		writeResult(w, stats, err)
+	case "gaps":
+		lateAfter := s.config.ResponseTimeout
+		if param := r.URL.Query().Get("lateAfter"); param != "" {
+			if lateAfter, err = time.ParseDuration(param); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("invalid lateAfter %s", param))
+				return
+			}
+		}
+		gaps, err := s.store.RoundGaps(aggregator, from, to)
+		if err != nil {
+			writeResult(w, nil, err)
+			return
+		}
+		late, err := s.store.LateRounds(aggregator, from, to, lateAfter)
+		writeResult(w, map[string]interface{}{
+			"missingRounds": gaps,
+			"lateRounds":    late,
+		}, err)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("no route for %s", r.URL.Path))
+	}
+}
+
+// parseTimeRange reads the from and to parameters, in seconds since the unix
+// epoch or RFC3339. The range defaults to the 24 hours before now.
+func parseTimeRange(r *http.Request, now time.Time) (from time.Time, to time.Time, err error) {
+	to = now
+	from = now.Add(-24 * time.Hour)
+	query := r.URL.Query()
+	if param := query.Get("to"); param != "" {
+		if to, err = parseTime(param); err != nil {
+			return from, to, fmt.Errorf("invalid to %s", param)
+		}
+		if query.Get("from") == "" {
+			from = to.Add(-24 * time.Hour)
+		}
+	}
+	if param := query.Get("from"); param != "" {
+		if from, err = parseTime(param); err != nil {
+			return from, to, fmt.Errorf("invalid from %s", param)
+		}
+	}
+	if !from.Before(to) {
+		return from, to, fmt.Errorf("from must be before to")
+	}
+	return from, to, nil
+}
+
+func parseTime(param string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(param, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, param)
+}
+
+func parseLimit(r *http.Request) (int, error) {
+	param := r.URL.Query().Get("limit")
+	if param == "" {
+		return defaultRoundsLimit, nil
+	}
+	limit, err := strconv.Atoi(param)
+	if err != nil || limit < 1 || limit > maxRoundsLimit {
+		return 0, fmt.Errorf("limit must be between 1 and %d", maxRoundsLimit)
+	}
+	return limit, nil
+}
+
+func writeResult(w http.ResponseWriter, result interface{}, err error) {
+	if err != nil {
+		logger.Errorw("Ingester API query failed", "error", err)
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("query failed"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": result})
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]interface{}{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Errorw("Writing response failed", "error", err)
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeRange(t *testing.T) {
+	now := time.Unix(1590086400, 0)
+
+	tests := []struct {
+		name      string
+		query     string
+		from, to  time.Time
+		wantError bool
+	}{
+		{"default", "", now.Add(-24 * time.Hour), now, false},
+		{"unix seconds", "?from=1590000000&to=1590003600", time.Unix(1590000000, 0), time.Unix(1590003600, 0), false},
+		{"rfc3339", "?from=2020-05-20T00:00:00Z", time.Date(2020, 5, 20, 0, 0, 0, 0, time.UTC), now, false},
+		{"to only", "?to=1590003600", time.Unix(1590003600-86400, 0), time.Unix(1590003600, 0), false},
+		{"invalid", "?from=yesterday", time.Time{}, time.Time{}, true},
+		{"reversed", "?from=1590003600&to=1590000000", time.Time{}, time.Time{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/aggregators/0x0/rounds"+test.query, nil)
+			from, to, err := parseTimeRange(r, now)
+			if test.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, test.from.Equal(from), "from %s", from)
+			assert.True(t, test.to.Equal(to), "to %s", to)
+		})
+	}
+}
+
+func TestServer_InvalidRequests(t *testing.T) {
+	s := newServer(TestConfig(), nil)
+	address := "0x79fEbF6B9F76853EDBcBc913e6aAE8232cFB9De9"
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		status int
+	}{
+		{"post", http.MethodPost, "/aggregators/" + address + "/rounds", http.StatusMethodNotAllowed},
+		{"invalid address", http.MethodGet, "/aggregators/0xnope/rounds", http.StatusBadRequest},
+		{"unknown resource", http.MethodGet, "/aggregators/" + address + "/answers", http.StatusNotFound},
+		{"missing resource", http.MethodGet, "/aggregators/" + address, http.StatusNotFound},
+		{"invalid limit", http.MethodGet, "/aggregators/" + address + "/rounds?limit=0", http.StatusBadRequest},
+		{"invalid lateAfter", http.MethodGet, "/aggregators/" + address + "/gaps?lateAfter=soon", http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			s.http.Handler.ServeHTTP(w, httptest.NewRequest(test.method, test.path, nil))
+			assert.Equal(t, test.status, w.Code)
+			assert.Contains(t, w.Body.String(), `"error"`)
+		})
+	}
+}
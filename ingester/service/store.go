@@ -0,0 +1,203 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"ingester/logger"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// eventTables lists the tables of decoded events, which all share the columns
+// identifying the log they were decoded from
+var eventTables = []string{
+	"flux_aggregator_answer_updated",
+	"flux_aggregator_new_round",
+	"flux_aggregator_submission_received",
+	"oracle_request",
+}
+
+// store reads and writes the ingested chain data in postgres
+type store struct {
+	db *sql.DB
+}
+
+// SaveLog saves a raw log and, if it belongs to a known event, its decoded
+// event. A removed log is kept but flagged as removed and orphaned. A log
+// that fails to decode, such as one from another contract sharing an event
+// topic, is only saved raw.
+func (s *store) SaveLog(log types.Log) error {
+	event, err := DecodeLog(log)
+	if err != nil {
+		logger.Warnw("Unable to decode log, saving it undecoded", "address", log.Address, "blockHash", log.BlockHash, "index", log.Index, "error", err)
+		event = nil
+	}
+
+	return s.transact(func(tx *sql.Tx) error {
+		address := make([]byte, 20)
+		copy(address, log.Address[:])
+
+		topics := make([]byte, len(log.Topics)*len(common.Hash{}))
+		for index, topic := range log.Topics {
+			copy(topics[index*len(common.Hash{}):], topic.Bytes())
+		}
+
+		_, err := tx.Exec(`INSERT INTO "ethereum_log" ("address", "topics", "data", "blockNumber", "txHash", "txIndex", "blockHash", "index", "removed") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT ("blockHash", "index") DO UPDATE SET "removed" = EXCLUDED."removed";`,
+			address,
+			topics,
+			log.Data,
+			log.BlockNumber,
+			log.TxHash.Bytes(),
+			log.TxIndex,
+			log.BlockHash.Bytes(),
+			log.Index,
+			log.Removed)
+		if err != nil {
+			return errors.Wrap(err, "inserting log")
+		}
+		if event == nil {
+			return nil
+		}
+
+		table, columns, values := eventRow(event)
+		columns = append([]string{"address", "blockNumber", "blockHash", "txHash", "logIndex", "orphaned"}, columns...)
+		values = append([]interface{}{address, log.BlockNumber, log.BlockHash.Bytes(), log.TxHash.Bytes(), log.Index, log.Removed}, values...)
+		placeholders := make([]string, len(columns))
+		for i := range columns {
+			columns[i] = fmt.Sprintf(`"%s"`, columns[i])
+			placeholders[i] = fmt.Sprintf("$%d", i+1)
+		}
+		_, err = tx.Exec(fmt.Sprintf(`INSERT INTO "%s" (%s) VALUES (%s)
+			ON CONFLICT ("blockHash", "logIndex") DO UPDATE SET "orphaned" = EXCLUDED."orphaned";`,
+			table, strings.Join(columns, ", "), strings.Join(placeholders, ", ")),
+			values...)
+		return errors.Wrapf(err, "inserting %s", table)
+	})
+}
+
+func eventRow(event interface{}) (table string, columns []string, values []interface{}) {
+	switch e := event.(type) {
+	case AnswerUpdated:
+		return "flux_aggregator_answer_updated",
+			[]string{"current", "roundId", "timestamp"},
+			[]interface{}{e.Current.String(), e.RoundID.String(), e.Timestamp.String()}
+	case NewRound:
+		return "flux_aggregator_new_round",
+			[]string{"roundId", "startedBy", "startedAt"},
+			[]interface{}{e.RoundID.String(), e.StartedBy.Bytes(), e.StartedAt.String()}
+	case SubmissionReceived:
+		return "flux_aggregator_submission_received",
+			[]string{"submission", "round", "oracle"},
+			[]interface{}{e.Submission.String(), e.Round, e.Oracle.Bytes()}
+	case OracleRequest:
+		return "oracle_request",
+			[]string{"specId", "requester", "requestId", "payment", "callbackAddr", "callbackFunctionId", "cancelExpiration", "dataVersion", "data"},
+			[]interface{}{e.SpecID.Bytes(), e.Requester.Bytes(), e.RequestID.Bytes(), e.Payment.String(), e.CallbackAddr.Bytes(), e.CallbackFunctionID[:], e.CancelExpiration.String(), e.DataVersion.String(), e.Data}
+	}
+	panic(fmt.Sprintf("unknown event type %T", event))
+}
+
+// SaveHead saves a head as part of the canonical chain
+func (s *store) SaveHead(head *types.Header) error {
+	nonce := make([]byte, 8)
+	copy(nonce, head.Nonce[:])
+
+	_, err := s.db.Exec(`INSERT INTO "ethereum_head" ("blockHash", "parentHash", "uncleHash", "coinbase", "root", "txHash", "receiptHash", "bloom", "difficulty", "number", "gasLimit", "gasUsed", "time", "extra", "mixDigest", "nonce") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		ON CONFLICT ("blockHash") DO UPDATE SET "orphaned" = FALSE;`,
+		head.Hash().Bytes(),
+		head.ParentHash,
+		head.UncleHash,
+		head.Coinbase,
+		head.Root,
+		head.TxHash,
+		head.ReceiptHash,
+		head.Bloom.Bytes(),
+		head.Difficulty.String(),
+		head.Number.String(),
+		head.GasLimit,
+		head.GasUsed,
+		head.Time,
+		head.Extra,
+		head.MixDigest,
+		nonce)
+	return errors.Wrap(err, "inserting head")
+}
+
+// CanonicalHeads returns the hashes of the heads at the given height, or
+// above it when orAbove is set, that are not orphaned
+func (s *store) CanonicalHeads(number uint64, orAbove bool) ([]common.Hash, error) {
+	op := "="
+	if orAbove {
+		op = ">="
+	}
+	rows, err := s.db.Query(fmt.Sprintf(`SELECT "blockHash" FROM "ethereum_head" WHERE "number" %s $1 AND NOT "orphaned";`, op), number)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading heads")
+	}
+	defer rows.Close()
+
+	var hashes []common.Hash
+	for rows.Next() {
+		var hash []byte
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, common.BytesToHash(hash))
+	}
+	return hashes, rows.Err()
+}
+
+// OrphanBlock flags a block that left the canonical chain, along with its
+// logs and their decoded events
+func (s *store) OrphanBlock(hash common.Hash) error {
+	return s.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`UPDATE "ethereum_head" SET "orphaned" = TRUE WHERE "blockHash" = $1;`, hash.Bytes()); err != nil {
+			return errors.Wrap(err, "orphaning head")
+		}
+		if _, err := tx.Exec(`UPDATE "ethereum_log" SET "removed" = TRUE WHERE "blockHash" = $1;`, hash.Bytes()); err != nil {
+			return errors.Wrap(err, "removing logs")
+		}
+		for _, table := range eventTables {
+			if _, err := tx.Exec(fmt.Sprintf(`UPDATE "%s" SET "orphaned" = TRUE WHERE "blockHash" = $1;`, table), hash.Bytes()); err != nil {
+				return errors.Wrapf(err, "orphaning %s", table)
+			}
+		}
+		return nil
+	})
+}
+
+// Cursor returns the last block the logs of the chain were ingested up to,
+// and false if the chain was never ingested
+func (s *store) Cursor(chainID int) (uint64, bool, error) {
+	var blockNumber uint64
+	err := s.db.QueryRow(`SELECT "blockNumber" FROM "ingester_cursor" WHERE "chainId" = $1;`, chainID).Scan(&blockNumber)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	return blockNumber, err == nil, errors.Wrap(err, "loading cursor")
+}
+
+// SaveCursor moves the cursor of the chain forward to the given block
+func (s *store) SaveCursor(chainID int, blockNumber uint64) error {
+	_, err := s.db.Exec(`INSERT INTO "ingester_cursor" ("chainId", "blockNumber") VALUES ($1, $2)
+		ON CONFLICT ("chainId") DO UPDATE SET "blockNumber" = GREATEST("ingester_cursor"."blockNumber", EXCLUDED."blockNumber"), "updatedAt" = now();`,
+		chainID, blockNumber)
+	return errors.Wrap(err, "saving cursor")
+}
+
+func (s *store) transact(fn func(*sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,282 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSchema holds the tables the explorer migrations create for the ingester
+const testSchema = `
+CREATE TABLE ethereum_head (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "parentHash" bytea NOT NULL,
+  "uncleHash" bytea NOT NULL,
+  "coinbase" bytea NOT NULL,
+  "root" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "receiptHash" bytea NOT NULL,
+  "bloom" bytea NOT NULL,
+  "difficulty" numeric NOT NULL,
+  "number" numeric NOT NULL,
+  "gasLimit" bigint NOT NULL,
+  "gasUsed" bigint NOT NULL,
+  "time" bigint NOT NULL,
+  "extra" bytea NOT NULL,
+  "mixDigest" bytea NOT NULL,
+  "nonce" bytea NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE
+);
+CREATE UNIQUE INDEX "idx_ethereum_head_block_hash" ON ethereum_head ("blockHash");
+
+CREATE TABLE ethereum_log (
+  "id" BIGSERIAL PRIMARY KEY,
+  "address" bytea NOT NULL,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "topics" bytea NOT NULL,
+  "data" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "txHash" bytea NOT NULL,
+  "txIndex" bytea NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "index" bigint NOT NULL,
+  "removed" bool NOT NULL DEFAULT FALSE
+);
+CREATE UNIQUE INDEX "idx_ethereum_log_block_hash_index" ON ethereum_log ("blockHash", "index");
+
+CREATE TABLE flux_aggregator_answer_updated (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "address" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "logIndex" bigint NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE,
+  "current" numeric NOT NULL,
+  "roundId" numeric NOT NULL,
+  "timestamp" numeric NOT NULL,
+  UNIQUE ("blockHash", "logIndex")
+);
+
+CREATE TABLE flux_aggregator_new_round (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "address" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "logIndex" bigint NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE,
+  "roundId" numeric NOT NULL,
+  "startedBy" bytea NOT NULL,
+  "startedAt" numeric NOT NULL,
+  UNIQUE ("blockHash", "logIndex")
+);
+
+CREATE TABLE flux_aggregator_submission_received (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "address" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "logIndex" bigint NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE,
+  "submission" numeric NOT NULL,
+  "round" bigint NOT NULL,
+  "oracle" bytea NOT NULL,
+  UNIQUE ("blockHash", "logIndex")
+);
+
+CREATE TABLE oracle_request (
+  "id" BIGSERIAL PRIMARY KEY,
+  "createdAt" timestamp without time zone DEFAULT now() NOT NULL,
+  "address" bytea NOT NULL,
+  "blockNumber" bigint NOT NULL,
+  "blockHash" bytea NOT NULL,
+  "txHash" bytea NOT NULL,
+  "logIndex" bigint NOT NULL,
+  "orphaned" bool NOT NULL DEFAULT FALSE,
+  "specId" bytea NOT NULL,
+  "requester" bytea NOT NULL,
+  "requestId" bytea NOT NULL,
+  "payment" numeric NOT NULL,
+  "callbackAddr" bytea NOT NULL,
+  "callbackFunctionId" bytea NOT NULL,
+  "cancelExpiration" numeric NOT NULL,
+  "dataVersion" numeric NOT NULL,
+  "data" bytea NOT NULL,
+  UNIQUE ("blockHash", "logIndex")
+);
+
+CREATE TABLE ingester_cursor (
+  "chainId" bigint PRIMARY KEY,
+  "blockNumber" bigint NOT NULL,
+  "updatedAt" timestamp without time zone DEFAULT now() NOT NULL
+);
+`
+
+// newTestStore returns a store on a new schema of the test config's database,
+// and a function dropping it. The test is skipped if postgres is unreachable.
+func newTestStore(t *testing.T) (*store, func()) {
+	config := TestConfig()
+	psqlInfo := fmt.Sprintf(
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+		config.DatabaseHost,
+		config.DatabasePort,
+		config.DatabaseUsername,
+		config.DatabasePassword,
+		config.DatabaseName,
+	)
+	admin, err := sql.Open("postgres", psqlInfo)
+	require.NoError(t, err)
+	if err := admin.Ping(); err != nil {
+		admin.Close()
+		t.Skipf("postgres is unreachable: %v", err)
+	}
+
+	schema := fmt.Sprintf("ingester_test_%d", time.Now().UnixNano())
+	_, err = admin.Exec(fmt.Sprintf(`CREATE SCHEMA "%s";`, schema))
+	require.NoError(t, err)
+	cleanup := func() {
+		_, _ = admin.Exec(fmt.Sprintf(`DROP SCHEMA "%s" CASCADE;`, schema))
+		admin.Close()
+	}
+
+	db, err := sql.Open("postgres", psqlInfo+" search_path="+schema)
+	if err == nil {
+		_, err = db.Exec(testSchema)
+	}
+	if err != nil {
+		cleanup()
+		require.NoError(t, err)
+	}
+	return &store{db: db}, func() {
+		db.Close()
+		cleanup()
+	}
+}
+
+// testLog returns a log of the aggregator at the given block, whose hash is
+// derived from its number
+func testLog(aggregator common.Address, blockNumber uint64, index uint, topics []common.Hash, data []byte) types.Log {
+	return types.Log{
+		Address:     aggregator,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: blockNumber,
+		BlockHash:   common.BigToHash(new(big.Int).SetUint64(blockNumber)),
+		TxHash:      common.BigToHash(big.NewInt(int64(index + 1))),
+		Index:       index,
+	}
+}
+
+func newRoundLog(t *testing.T, aggregator common.Address, blockNumber uint64, index uint, roundID, startedAt int64) types.Log {
+	data, err := contracts.Events["NewRound"].Inputs.NonIndexed().Pack(big.NewInt(startedAt))
+	require.NoError(t, err)
+	topics := []common.Hash{NewRoundTopic, common.BigToHash(big.NewInt(roundID)), common.HexToHash("0x01")}
+	return testLog(aggregator, blockNumber, index, topics, data)
+}
+
+func answerUpdatedLog(t *testing.T, aggregator common.Address, blockNumber uint64, index uint, current, roundID, timestamp int64) types.Log {
+	data, err := contracts.Events["AnswerUpdated"].Inputs.NonIndexed().Pack(big.NewInt(timestamp))
+	require.NoError(t, err)
+	topics := []common.Hash{AnswerUpdatedTopic, common.BigToHash(math.U256(big.NewInt(current))), common.BigToHash(big.NewInt(roundID))}
+	return testLog(aggregator, blockNumber, index, topics, data)
+}
+
+func submissionLog(aggregator common.Address, blockNumber uint64, index uint, submission, round int64, oracle common.Address) types.Log {
+	topics := []common.Hash{SubmissionReceivedTopic, common.BigToHash(math.U256(big.NewInt(submission))), common.BigToHash(big.NewInt(round)), oracle.Hash()}
+	return testLog(aggregator, blockNumber, index, topics, nil)
+}
+
+func queryBool(t *testing.T, st *store, query string, args ...interface{}) bool {
+	var value bool
+	require.NoError(t, st.db.QueryRow(query, args...).Scan(&value))
+	return value
+}
+
+func queryCount(t *testing.T, st *store, query string, args ...interface{}) int {
+	var count int
+	require.NoError(t, st.db.QueryRow(query, args...).Scan(&count))
+	return count
+}
+
+func TestStore_SaveLog_Upserts(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	aggregator := common.HexToAddress("0x79fEbF6B9F76853EDBcBc913e6aAE8232cFB9De9")
+	log := newRoundLog(t, aggregator, 10, 0, 1, 1590000000)
+	require.NoError(t, st.SaveLog(log))
+	require.NoError(t, st.SaveLog(log))
+	assert.Equal(t, 1, queryCount(t, st, `SELECT COUNT(*) FROM "ethereum_log";`))
+	assert.Equal(t, 1, queryCount(t, st, `SELECT COUNT(*) FROM "flux_aggregator_new_round" WHERE "roundId" = 1 AND "startedAt" = 1590000000;`))
+
+	log.Removed = true
+	require.NoError(t, st.SaveLog(log))
+	assert.True(t, queryBool(t, st, `SELECT "removed" FROM "ethereum_log";`))
+	assert.True(t, queryBool(t, st, `SELECT "orphaned" FROM "flux_aggregator_new_round";`))
+
+	log.Removed = false
+	require.NoError(t, st.SaveLog(log))
+	assert.False(t, queryBool(t, st, `SELECT "removed" FROM "ethereum_log";`))
+	assert.False(t, queryBool(t, st, `SELECT "orphaned" FROM "flux_aggregator_new_round";`))
+}
+
+func TestStore_SaveLog_Undecodable(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	aggregator := common.HexToAddress("0x79fEbF6B9F76853EDBcBc913e6aAE8232cFB9De9")
+	require.NoError(t, st.SaveLog(testLog(aggregator, 10, 0, []common.Hash{NewRoundTopic}, nil)))
+	assert.Equal(t, 1, queryCount(t, st, `SELECT COUNT(*) FROM "ethereum_log";`))
+	assert.Equal(t, 0, queryCount(t, st, `SELECT COUNT(*) FROM "flux_aggregator_new_round";`))
+}
+
+func TestStore_SaveHead_Upserts(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	head := testHeader(1, common.Hash{}, "a")
+	require.NoError(t, st.SaveHead(head))
+	require.NoError(t, st.OrphanBlock(head.Hash()))
+	assert.True(t, queryBool(t, st, `SELECT "orphaned" FROM "ethereum_head";`))
+
+	require.NoError(t, st.SaveHead(head))
+	assert.Equal(t, 1, queryCount(t, st, `SELECT COUNT(*) FROM "ethereum_head";`))
+	assert.False(t, queryBool(t, st, `SELECT "orphaned" FROM "ethereum_head";`))
+
+	hashes, err := st.CanonicalHeads(1, false)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{head.Hash()}, hashes)
+}
+
+func TestStore_SaveCursor(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	_, ok, err := st.Cursor(1)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, st.SaveCursor(1, 10))
+	require.NoError(t, st.SaveCursor(1, 5))
+	require.NoError(t, st.SaveCursor(3, 2))
+
+	cursor, ok, err := st.Cursor(1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(10), cursor)
+}